@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,19 +28,21 @@ func NewApiDataSource() datasource.DataSource {
 
 // apiDataSource is the data source implementation.
 type apiDataSource struct {
-	client *backstage.Client
+	client *client.Client
 }
 
 type apiDataSourceModel struct {
-	ID         types.String          `tfsdk:"id"`
-	Name       types.String          `tfsdk:"name"`
-	Namespace  types.String          `tfsdk:"namespace"`
-	ApiVersion types.String          `tfsdk:"api_version"`
-	Kind       types.String          `tfsdk:"kind"`
-	Metadata   *entityMetadataModel  `tfsdk:"metadata"`
-	Relations  []entityRelationModel `tfsdk:"relations"`
-	Spec       *apiSpecModel         `tfsdk:"spec"`
-	Fallback   *apiFallbackModel     `tfsdk:"fallback"`
+	ID                     types.String            `tfsdk:"id"`
+	Name                   types.String            `tfsdk:"name"`
+	Namespace              types.String            `tfsdk:"namespace"`
+	ApiVersion             types.String            `tfsdk:"api_version"`
+	Kind                   types.String            `tfsdk:"kind"`
+	Metadata               *entityMetadataModel    `tfsdk:"metadata"`
+	Relations              []entityRelationModel   `tfsdk:"relations"`
+	Status                 []entityStatusItemModel `tfsdk:"status"`
+	Spec                   *apiSpecModel           `tfsdk:"spec"`
+	FailOnProcessingErrors types.Bool              `tfsdk:"fail_on_processing_errors"`
+	Fallback               *apiFallbackModel       `tfsdk:"fallback"`
 }
 
 type apiSpecModel struct {
@@ -96,8 +99,9 @@ func (d *apiDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 					"must follow Backstage format restrictions",
 				),
 			}},
-			"api_version": schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
-			"kind":        schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntityFailOnProcessingErrors},
+			"api_version":               schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+			"kind":                      schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
 			"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
 				"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
 				"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
@@ -129,6 +133,17 @@ func (d *apiDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 						}},
 				},
 			}},
+			"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+					"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+					"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+						"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+					}},
+				},
+			}},
 			"spec": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntitySpec, Attributes: map[string]schema.Attribute{
 				"type":       schema.StringAttribute{Computed: true, Description: descriptionApiSpecType},
 				"lifecycle":  schema.StringAttribute{Computed: true, Description: descriptionApiSpecLifecycle},
@@ -203,7 +218,7 @@ func (d *apiDataSource) Configure(_ context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	d.client = req.ProviderData.(*backstage.Client)
+	d.client = req.ProviderData.(*client.Client)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -231,7 +246,7 @@ func (d *apiDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		resp.Diagnostics.AddWarning(shortErr, longErr)
 	}
 
-	if response.StatusCode != http.StatusOK {
+	if err == nil && response.StatusCode != http.StatusOK {
 		const shortErr = "Error reading Backstage API kind"
 		longErr := fmt.Sprintf("Could not read Backstage API kind %s/%s: %s", state.Namespace.ValueString(), state.Name.ValueString(), response.Status)
 		if state.Fallback == nil {
@@ -261,6 +276,13 @@ func (d *apiDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		state.Spec = state.Fallback.Spec
 	}
 	if err == nil && response.StatusCode == http.StatusOK {
+		addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("API kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+
+		state.Status = newEntityStatusItemModels(api.Status)
+		if state.FailOnProcessingErrors.ValueBool() {
+			addEntityStatusErrors(&resp.Diagnostics, api.Status, fmt.Sprintf("API kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+		}
+
 		state.ID = types.StringValue(api.Metadata.UID)
 		state.ApiVersion = types.StringValue(api.ApiVersion)
 		state.Kind = types.StringValue(api.Kind)
@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,19 +28,21 @@ func NewComponentDataSource() datasource.DataSource {
 
 // componentDataSource is the data source implementation.
 type componentDataSource struct {
-	client *backstage.Client
+	client *client.Client
 }
 
 type componentDataSourceModel struct {
-	ID         types.String            `tfsdk:"id"`
-	Name       types.String            `tfsdk:"name"`
-	Namespace  types.String            `tfsdk:"namespace"`
-	ApiVersion types.String            `tfsdk:"api_version"`
-	Kind       types.String            `tfsdk:"kind"`
-	Metadata   *entityMetadataModel    `tfsdk:"metadata"`
-	Relations  []entityRelationModel   `tfsdk:"relations"`
-	Spec       *componentSpecModel     `tfsdk:"spec"`
-	Fallback   *componentFallbackModel `tfsdk:"fallback"`
+	ID                     types.String            `tfsdk:"id"`
+	Name                   types.String            `tfsdk:"name"`
+	Namespace              types.String            `tfsdk:"namespace"`
+	ApiVersion             types.String            `tfsdk:"api_version"`
+	Kind                   types.String            `tfsdk:"kind"`
+	Metadata               *entityMetadataModel    `tfsdk:"metadata"`
+	Relations              []entityRelationModel   `tfsdk:"relations"`
+	Status                 []entityStatusItemModel `tfsdk:"status"`
+	Spec                   *componentSpecModel     `tfsdk:"spec"`
+	FailOnProcessingErrors types.Bool              `tfsdk:"fail_on_processing_errors"`
+	Fallback               *componentFallbackModel `tfsdk:"fallback"`
 }
 
 type componentSpecModel struct {
@@ -102,8 +105,9 @@ func (d *componentDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 					"must follow Backstage format restrictions",
 				),
 			}},
-			"api_version": schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
-			"kind":        schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntityFailOnProcessingErrors},
+			"api_version":               schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+			"kind":                      schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
 			"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
 				"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
 				"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
@@ -135,6 +139,17 @@ func (d *componentDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 						}},
 				},
 			}},
+			"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+					"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+					"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+						"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+					}},
+				},
+			}},
 			"spec": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntitySpec, Attributes: map[string]schema.Attribute{
 				"type":            schema.StringAttribute{Computed: true, Description: descriptionComponentSpecType},
 				"lifecycle":       schema.StringAttribute{Computed: true, Description: descriptionComponentSpecLifecycle},
@@ -215,7 +230,7 @@ func (d *componentDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	d.client = req.ProviderData.(*backstage.Client)
+	d.client = req.ProviderData.(*client.Client)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -243,7 +258,7 @@ func (d *componentDataSource) Read(ctx context.Context, req datasource.ReadReque
 		resp.Diagnostics.AddWarning(shortErr, longErr)
 	}
 
-	if response.StatusCode != http.StatusOK {
+	if err == nil && response.StatusCode != http.StatusOK {
 		const shortErr = "Error reading Backstage Component kind"
 		longErr := fmt.Sprintf("Could not read Backstage Component kind %s/%s: %s", state.Namespace.ValueString(), state.Name.ValueString(), response.Status)
 		if state.Fallback == nil {
@@ -273,6 +288,13 @@ func (d *componentDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	if err == nil && response.StatusCode == http.StatusOK {
+		addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("Component kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+
+		state.Status = newEntityStatusItemModels(component.Status)
+		if state.FailOnProcessingErrors.ValueBool() {
+			addEntityStatusErrors(&resp.Diagnostics, component.Status, fmt.Sprintf("Component kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+		}
+
 		state.ID = types.StringValue(component.Metadata.UID)
 		state.ApiVersion = types.StringValue(component.ApiVersion)
 		state.Kind = types.StringValue(component.Kind)
@@ -1,9 +1,16 @@
 package backstage
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDataSourceComponent(t *testing.T) {
@@ -60,3 +67,45 @@ func TestAccDataSourceComponent_WithFallback(t *testing.T) {
 		},
 	})
 }
+
+func TestComponentDataSource_FallbackOnTransportError(t *testing.T) {
+	readResp := readDataSource(t, &componentDataSource{client: newUnreachableClient(t)}, &componentDataSourceModel{
+		Name:     types.StringValue("artist-web"),
+		Fallback: &componentFallbackModel{Name: types.StringValue("artist-web"), Namespace: types.StringValue("default")},
+	})
+	assert.Falsef(t, readResp.Diagnostics.HasError(), "Read should not return errors, when fallback is set")
+	assert.Equalf(t, 1, readResp.Diagnostics.WarningsCount(), "Transport error should be reported as a warning")
+
+	var state componentDataSourceModel
+	readResp.State.Get(context.Background(), &state)
+	assert.Equalf(t, "123456789", state.ID.ValueString(), "Fallback should be used")
+}
+
+func TestComponentDataSource_StaleResponseOnTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"uid":"uid-1","name":"artist-web",` +
+			`"namespace":"default"},"spec":{"type":"website","lifecycle":"production","owner":"team-a"}}`))
+	}))
+
+	diskCache, err := transport.NewDiskCacheTransport(t.TempDir(), 0, nil)
+	assert.NoErrorf(t, err, "Transport should be created")
+	c, err := client.NewClient(server.URL, "", diskCache.Client())
+	assert.NoErrorf(t, err, "Client should be created")
+	d := &componentDataSource{client: c}
+
+	readResp := readDataSource(t, d, &componentDataSourceModel{Name: types.StringValue("artist-web")})
+	assert.Emptyf(t, readResp.Diagnostics, "Live read should not return diagnostics")
+
+	server.Close()
+
+	readResp = readDataSource(t, d, &componentDataSourceModel{Name: types.StringValue("artist-web")})
+	assert.Falsef(t, readResp.Diagnostics.HasError(), "Read should not return errors, when the response is cached")
+	if assert.Equalf(t, 1, readResp.Diagnostics.WarningsCount(), "Stale response should be reported as a warning") {
+		assert.Containsf(t, readResp.Diagnostics.Warnings()[0].Detail(), "cached", "Warning should state the age of the response")
+	}
+
+	var state componentDataSourceModel
+	readResp.State.Get(context.Background(), &state)
+	assert.Equalf(t, "uid-1", state.ID.ValueString(), "Cached entity should be used")
+	assert.Equalf(t, "team-a", state.Spec.Owner.ValueString(), "Cached spec should be used")
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,19 +28,21 @@ func NewDomainDataSource() datasource.DataSource {
 
 // domainDataSource is the data source implementation.
 type domainDataSource struct {
-	client *backstage.Client
+	client *client.Client
 }
 
 type domainDataSourceModel struct {
-	ID         types.String          `tfsdk:"id"`
-	Name       types.String          `tfsdk:"name"`
-	Namespace  types.String          `tfsdk:"namespace"`
-	ApiVersion types.String          `tfsdk:"api_version"`
-	Kind       types.String          `tfsdk:"kind"`
-	Metadata   *entityMetadataModel  `tfsdk:"metadata"`
-	Relations  []entityRelationModel `tfsdk:"relations"`
-	Spec       *domainSpecModel      `tfsdk:"spec"`
-	Fallback   *domainFallbackModel  `tfsdk:"fallback"`
+	ID                     types.String            `tfsdk:"id"`
+	Name                   types.String            `tfsdk:"name"`
+	Namespace              types.String            `tfsdk:"namespace"`
+	ApiVersion             types.String            `tfsdk:"api_version"`
+	Kind                   types.String            `tfsdk:"kind"`
+	Metadata               *entityMetadataModel    `tfsdk:"metadata"`
+	Relations              []entityRelationModel   `tfsdk:"relations"`
+	Status                 []entityStatusItemModel `tfsdk:"status"`
+	Spec                   *domainSpecModel        `tfsdk:"spec"`
+	FailOnProcessingErrors types.Bool              `tfsdk:"fail_on_processing_errors"`
+	Fallback               *domainFallbackModel    `tfsdk:"fallback"`
 }
 
 type domainFallbackModel struct {
@@ -88,8 +91,9 @@ func (d *domainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					"must follow Backstage format restrictions",
 				),
 			}},
-			"api_version": schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
-			"kind":        schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntityFailOnProcessingErrors},
+			"api_version":               schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+			"kind":                      schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
 			"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
 				"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
 				"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
@@ -121,6 +125,17 @@ func (d *domainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 						}},
 				},
 			}},
+			"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+					"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+					"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+						"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+					}},
+				},
+			}},
 			"spec": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntitySpec, Attributes: map[string]schema.Attribute{
 				"owner": schema.StringAttribute{Computed: true, Description: descriptionDomainSpecOwner},
 			}},
@@ -187,7 +202,7 @@ func (d *domainDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	d.client = req.ProviderData.(*backstage.Client)
+	d.client = req.ProviderData.(*client.Client)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -215,7 +230,7 @@ func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		resp.Diagnostics.AddWarning(shortErr, longErr)
 	}
 
-	if response.StatusCode != http.StatusOK {
+	if err == nil && response.StatusCode != http.StatusOK {
 		const shortErr = "Error reading Backstage Domain kind"
 		longErr := fmt.Sprintf("Could not read Backstage Domain kind %s/%s: %s", state.Namespace.ValueString(), state.Name.ValueString(), response.Status)
 		if state.Fallback == nil {
@@ -246,6 +261,13 @@ func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	if err == nil && response.StatusCode == http.StatusOK {
+		addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("Domain kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+
+		state.Status = newEntityStatusItemModels(domain.Status)
+		if state.FailOnProcessingErrors.ValueBool() {
+			addEntityStatusErrors(&resp.Diagnostics, domain.Status, fmt.Sprintf("Domain kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+		}
+
 		state.ID = types.StringValue(domain.Metadata.UID)
 		state.ApiVersion = types.StringValue(domain.ApiVersion)
 		state.Kind = types.StringValue(domain.Kind)
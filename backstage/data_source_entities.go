@@ -7,9 +7,17 @@ import (
 	"net/http"
 
 	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,22 +34,34 @@ func NewEntityDataSource() datasource.DataSource {
 
 // entityDataSource is the data source implementation.
 type entityDataSource struct {
-	client *backstage.Client
+	client *client.Client
 }
 
 type entityDataSourceModel struct {
-	ID       types.String         `tfsdk:"id"`
-	Filters  []string             `tfsdk:"filters"`
-	Entities []entityModel        `tfsdk:"entities"`
-	Fallback *entityFallbackModel `tfsdk:"fallback"`
+	ID                     types.String         `tfsdk:"id"`
+	Filters                []string             `tfsdk:"filters"`
+	Fields                 []string             `tfsdk:"fields"`
+	Order                  []entityOrderModel   `tfsdk:"order"`
+	Limit                  types.Int64          `tfsdk:"limit"`
+	FullTextTerm           types.String         `tfsdk:"full_text_term"`
+	FullTextFields         []string             `tfsdk:"full_text_fields"`
+	Entities               []entityModel        `tfsdk:"entities"`
+	FailOnProcessingErrors types.Bool           `tfsdk:"fail_on_processing_errors"`
+	Fallback               *entityFallbackModel `tfsdk:"fallback"`
+}
+
+type entityOrderModel struct {
+	Field     types.String `tfsdk:"field"`
+	Direction types.String `tfsdk:"direction"`
 }
 
 type entityModel struct {
-	ApiVersion types.String          `tfsdk:"api_version"`
-	Spec       jsontypes.Normalized  `tfsdk:"spec"`
-	Kind       types.String          `tfsdk:"kind"`
-	Metadata   *entityMetadataModel  `tfsdk:"metadata"`
-	Relations  []entityRelationModel `tfsdk:"relations"`
+	ApiVersion types.String            `tfsdk:"api_version"`
+	Spec       jsontypes.Normalized    `tfsdk:"spec"`
+	Kind       types.String            `tfsdk:"kind"`
+	Metadata   *entityMetadataModel    `tfsdk:"metadata"`
+	Relations  []entityRelationModel   `tfsdk:"relations"`
+	Status     []entityStatusItemModel `tfsdk:"status"`
 }
 
 type entityMetadataModel struct {
@@ -76,6 +96,18 @@ type entityLinkModel struct {
 	Type  types.String `tfsdk:"type"`
 }
 
+type entityStatusItemModel struct {
+	Type    types.String                `tfsdk:"type"`
+	Level   types.String                `tfsdk:"level"`
+	Message types.String                `tfsdk:"message"`
+	Error   *entityStatusItemErrorModel `tfsdk:"error"`
+}
+
+type entityStatusItemErrorModel struct {
+	Name    types.String `tfsdk:"name"`
+	Message types.String `tfsdk:"message"`
+}
+
 type entityFallbackModel struct {
 	ID       types.String  `tfsdk:"id"`
 	Filters  []string      `tfsdk:"filters"`
@@ -83,8 +115,20 @@ type entityFallbackModel struct {
 }
 
 const (
-	patternEntityName                  = `^[a-zA-Z0-9\-_\.]*$`
-	descriptionEntityFilters           = "A set of conditions that can be used to filter entities."
+	patternEntityName        = `^[a-zA-Z0-9\-_\.]*$`
+	entitiesPageSize         = 500
+	descriptionEntityFilters = "A set of conditions that can be used to filter entities."
+	descriptionEntityFields  = "A set of fields to limit the returned entities to, e.g. `metadata.name` or `spec.owner`. Fields not included " +
+		"are returned empty. All fields are returned, if not set."
+	descriptionEntityOrder          = "A set of conditions to order the entities by (`metadata.name` in ascending order, if not set)."
+	descriptionEntityOrderField     = "Field to order the entities by, e.g. `metadata.name`."
+	descriptionEntityOrderDirection = "Direction to order the entities in: `asc` or `desc` (default: `asc`)."
+	descriptionEntityFullTextTerm   = "A term to search the entities for, e.g. a part of their title or description. Only entities containing the " +
+		"term in any of `full_text_fields` are returned."
+	descriptionEntityFullTextFields = "A set of fields to search `full_text_term` in, e.g. `metadata.title` or `metadata.description`. " +
+		"Backstage searches its default fields, if not set."
+	descriptionEntityLimit = "Maximal number of entities to return. All matching entities are returned, if not set. Entities are fetched in " +
+		"pages, so large result sets do not have to be returned by a single request."
 	descriptionEntitySpec              = "The specification data describing the entity itself."
 	descriptionEntitySpecJson          = "The specification data describing the entity itself (as JSON)."
 	descriptionEntityApiVersion        = "Version of specification format for this particular entity that this is written against."
@@ -115,7 +159,16 @@ const (
 	descriptionEntityRelationTargetName      = "Name of the entity."
 	descriptionEntityRelationTargetKind      = "The high level entity type being described."
 	descriptionEntityRelationTargetNamespace = "Namespace that the target entity belongs to."
-	descriptionEntityFallback                = "A complete replica of the `Entity` as it would exist in backstage. Set this to provide a fallback in case the Backstage instance is not functioning, is down, or is unrealiable."
+	descriptionEntityStatus                  = "The current status of the entity, as claimed by various sources."
+	descriptionEntityStatusType              = "Type of the status item, e.g. `backstage.io/catalog-processing`."
+	descriptionEntityStatusLevel             = "Level of the status item: `info`, `warning` or `error`."
+	descriptionEntityStatusMessage           = "A brief message describing the status, intended for human consumption."
+	descriptionEntityStatusError             = "An error related to the status item."
+	descriptionEntityStatusErrorName         = "Type name of the error."
+	descriptionEntityStatusErrorMessage      = "Message of the error."
+	descriptionEntityFailOnProcessingErrors  = "Whether to fail, if the catalog reports errors in processing of the entity (default: `false`). The " +
+		"errors are reported by `status` items of `error` level."
+	descriptionEntityFallback = "A complete replica of the `Entity` as it would exist in backstage. Set this to provide a fallback in case the Backstage instance is not functioning, is down, or is unrealiable."
 )
 
 // Metadata returns the data source type name.
@@ -133,6 +186,34 @@ func (d *entityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 		Attributes: map[string]schema.Attribute{
 			"id":      schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
 			"filters": schema.ListAttribute{Required: true, Description: descriptionEntityFilters, ElementType: types.StringType},
+			"fields": schema.ListAttribute{Optional: true, MarkdownDescription: descriptionEntityFields, ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				}},
+			"order": schema.ListNestedAttribute{Optional: true, MarkdownDescription: descriptionEntityOrder, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"field": schema.StringAttribute{Required: true, MarkdownDescription: descriptionEntityOrderField, Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					}},
+					"direction": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionEntityOrderDirection, Validators: []validator.String{
+						stringvalidator.OneOf(backstage.OrderAscending, backstage.OrderDescending),
+					}},
+				},
+			}},
+			"full_text_term": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionEntityFullTextTerm, Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			}},
+			"full_text_fields": schema.ListAttribute{Optional: true, MarkdownDescription: descriptionEntityFullTextFields, ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					listvalidator.AlsoRequires(path.MatchRoot("full_text_term")),
+				}},
+			"limit": schema.Int64Attribute{Optional: true, Description: descriptionEntityLimit, Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			}},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntityFailOnProcessingErrors},
 			"entities": schema.ListNestedAttribute{Computed: true, Description: descriptionEntitySpec, NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
 					"api_version": schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
@@ -169,6 +250,17 @@ func (d *entityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 								}},
 						},
 					}},
+					"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+							"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+							"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+							"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+								"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+								"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+							}},
+						},
+					}},
 				},
 			}},
 			"fallback": schema.SingleNestedAttribute{Optional: true, Description: descriptionEntityFallback, Attributes: map[string]schema.Attribute{
@@ -210,6 +302,17 @@ func (d *entityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 									}},
 							},
 						}},
+						"status": schema.ListNestedAttribute{Optional: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type":    schema.StringAttribute{Optional: true, MarkdownDescription: descriptionEntityStatusType},
+								"level":   schema.StringAttribute{Optional: true, MarkdownDescription: descriptionEntityStatusLevel},
+								"message": schema.StringAttribute{Optional: true, Description: descriptionEntityStatusMessage},
+								"error": schema.SingleNestedAttribute{Optional: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+									"name":    schema.StringAttribute{Optional: true, Description: descriptionEntityStatusErrorName},
+									"message": schema.StringAttribute{Optional: true, Description: descriptionEntityStatusErrorMessage},
+								}},
+							},
+						}},
 					},
 				}},
 			}},
@@ -223,7 +326,7 @@ func (d *entityDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	d.client = req.ProviderData.(*backstage.Client)
+	d.client = req.ProviderData.(*client.Client)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -235,11 +338,59 @@ func (d *entityDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Getting entities %v from Backstage API", state.Filters))
-	entities, response, err := d.client.Catalog.Entities.List(ctx, &backstage.ListEntityOptions{
-		Filters: state.Filters,
-		Order:   []backstage.ListEntityOrder{{Field: "metadata.name", Direction: "asc"}},
-	})
+	options := &client.QueryEntitiesOptions{
+		Filters:        state.Filters,
+		Fields:         state.Fields,
+		Order:          []backstage.ListEntityOrder{{Field: "metadata.name", Direction: backstage.OrderAscending}},
+		FullTextTerm:   state.FullTextTerm.ValueString(),
+		FullTextFields: state.FullTextFields,
+		Limit:          entitiesPageSize,
+	}
+
+	if len(state.Order) > 0 {
+		options.Order = nil
+		for _, o := range state.Order {
+			order := backstage.ListEntityOrder{Field: o.Field.ValueString(), Direction: backstage.OrderAscending}
+			if !o.Direction.IsNull() {
+				order.Direction = o.Direction.ValueString()
+			}
+			options.Order = append(options.Order, order)
+		}
+	}
+
+	limit := int(state.Limit.ValueInt64())
+	if limit > 0 && limit < options.Limit {
+		options.Limit = limit
+	}
+
+	var entities []backstage.Entity
+	var response, staleResponse *http.Response
+	var err error
+	for {
+		tflog.Debug(ctx, fmt.Sprintf("Getting entities %v from Backstage API", state.Filters), map[string]interface{}{"backstage_cursor": options.Cursor})
+
+		var page *client.QueryEntitiesResponse
+		page, response, err = d.client.QueryEntities(ctx, options)
+		if err != nil || response.StatusCode != http.StatusOK {
+			break
+		}
+
+		if _, ok := transport.StaleAge(response); ok {
+			staleResponse = response
+		}
+
+		entities = append(entities, page.Items...)
+		if page.PageInfo.NextCursor == "" || (limit > 0 && len(entities) >= limit) {
+			break
+		}
+
+		options = &client.QueryEntitiesOptions{Fields: options.Fields, Limit: options.Limit, Cursor: page.PageInfo.NextCursor}
+	}
+
+	if limit > 0 && len(entities) > limit {
+		entities = entities[:limit]
+	}
+
 	if err != nil {
 		const shortErr = "Error reading Backstage entities"
 		longErr := fmt.Sprintf("Could not read Backstage entities %v: %s", state.Filters, err.Error())
@@ -250,7 +401,7 @@ func (d *entityDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		resp.Diagnostics.AddWarning(shortErr, longErr)
 	}
 
-	if response.StatusCode != http.StatusOK {
+	if err == nil && response.StatusCode != http.StatusOK {
 		const shortErr = "Error reading Backstage entities"
 		longErr := fmt.Sprintf("Could not read Backstage entities %v: %s", state.Filters, response.Status)
 		if state.Fallback == nil {
@@ -269,10 +420,12 @@ func (d *entityDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	if err == nil && response.StatusCode == http.StatusOK {
+		addStaleResponseWarning(&resp.Diagnostics, staleResponse, fmt.Sprintf("entities %v", state.Filters))
+
 		state.ID = types.StringValue(fmt.Sprint(state.Filters))
 
 		for _, e := range entities {
-			v, err := json.Marshal(e.Spec)
+			entity, err := newEntityModel(&e)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error parsing Backstage entity specs",
@@ -281,62 +434,135 @@ func (d *entityDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				continue
 			}
 
-			entity := entityModel{
-				ApiVersion: types.StringValue(e.ApiVersion),
-				Kind:       types.StringValue(e.Kind),
-				Spec:       jsontypes.NewNormalizedValue(string(v)),
+			if state.FailOnProcessingErrors.ValueBool() {
+				addEntityStatusErrors(&resp.Diagnostics, e.Status, fmt.Sprintf("%s kind %s/%s", e.Kind, e.Metadata.Namespace, e.Metadata.Name))
 			}
 
-			for _, i := range e.Relations {
-				entity.Relations = append(entity.Relations, entityRelationModel{
-					Type:      types.StringValue(i.Type),
-					TargetRef: types.StringValue(i.TargetRef),
-					Target: &entityRelationTargetModel{
-						Kind:      types.StringValue(i.Target.Kind),
-						Name:      types.StringValue(i.Target.Name),
-						Namespace: types.StringValue(i.Target.Namespace)},
-				})
-			}
+			state.Entities = append(state.Entities, entity)
+		}
+	}
 
-			entity.Metadata = &entityMetadataModel{
-				UID:         types.StringValue(e.Metadata.UID),
-				Etag:        types.StringValue(e.Metadata.Etag),
-				Name:        types.StringValue(e.Metadata.Name),
-				Namespace:   types.StringValue(e.Metadata.Namespace),
-				Title:       types.StringValue(e.Metadata.Title),
-				Description: types.StringValue(e.Metadata.Description),
-				Annotations: map[string]string{},
-				Labels:      map[string]string{},
-			}
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
 
-			for k, v := range e.Metadata.Labels {
-				entity.Metadata.Labels[k] = v
-			}
+// newEntityModel maps the entity returned by Backstage API to its data model.
+func newEntityModel(e *backstage.Entity) (entityModel, error) {
+	v, err := json.Marshal(e.Spec)
+	if err != nil {
+		return entityModel{}, err
+	}
 
-			for k, v := range e.Metadata.Annotations {
-				entity.Metadata.Annotations[k] = v
-			}
+	return entityModel{
+		ApiVersion: types.StringValue(e.ApiVersion),
+		Kind:       types.StringValue(e.Kind),
+		Spec:       jsontypes.NewNormalizedValue(string(v)),
+		Metadata:   newEntityMetadataModel(&e.Metadata),
+		Relations:  newEntityRelationModels(e.Relations),
+		Status:     newEntityStatusItemModels(e.Status),
+	}, nil
+}
 
-			for _, v := range e.Metadata.Tags {
-				entity.Metadata.Tags = append(entity.Metadata.Tags, types.StringValue(v))
-			}
+// newEntityMetadataModel maps the entity metadata returned by Backstage API to its data model.
+func newEntityMetadataModel(m *backstage.EntityMeta) *entityMetadataModel {
+	metadata := &entityMetadataModel{
+		UID:         types.StringValue(m.UID),
+		Etag:        types.StringValue(m.Etag),
+		Name:        types.StringValue(m.Name),
+		Namespace:   types.StringValue(m.Namespace),
+		Title:       types.StringValue(m.Title),
+		Description: types.StringValue(m.Description),
+		Annotations: map[string]string{},
+		Labels:      map[string]string{},
+	}
 
-			for _, v := range e.Metadata.Links {
-				entity.Metadata.Links = append(entity.Metadata.Links, entityLinkModel{
-					URL:   types.StringValue(v.URL),
-					Title: types.StringValue(v.Title),
-					Icon:  types.StringValue(v.Icon),
-					Type:  types.StringValue(v.Type),
-				})
-			}
+	for k, v := range m.Labels {
+		metadata.Labels[k] = v
+	}
 
-			state.Entities = append(state.Entities, entity)
+	for k, v := range m.Annotations {
+		metadata.Annotations[k] = v
+	}
+
+	for _, v := range m.Tags {
+		metadata.Tags = append(metadata.Tags, types.StringValue(v))
+	}
+
+	for _, v := range m.Links {
+		metadata.Links = append(metadata.Links, entityLinkModel{
+			URL:   types.StringValue(v.URL),
+			Title: types.StringValue(v.Title),
+			Icon:  types.StringValue(v.Icon),
+			Type:  types.StringValue(v.Type),
+		})
+	}
+
+	return metadata
+}
+
+// newEntityRelationModels maps the entity relations returned by Backstage API to their data models.
+func newEntityRelationModels(relations []backstage.EntityRelation) []entityRelationModel {
+	var models []entityRelationModel
+	for _, i := range relations {
+		models = append(models, entityRelationModel{
+			Type:      types.StringValue(i.Type),
+			TargetRef: types.StringValue(i.TargetRef),
+			Target: &entityRelationTargetModel{
+				Kind:      types.StringValue(i.Target.Kind),
+				Name:      types.StringValue(i.Target.Name),
+				Namespace: types.StringValue(i.Target.Namespace)},
+		})
+	}
+
+	return models
+}
+
+// newEntityStatusItemModels maps the entity status returned by Backstage API to the data models of its items.
+func newEntityStatusItemModels(status *backstage.EntityStatus) []entityStatusItemModel {
+	if status == nil {
+		return nil
+	}
+
+	var models []entityStatusItemModel
+	for _, i := range status.Items {
+		item := entityStatusItemModel{
+			Type:    types.StringValue(i.Type),
+			Level:   types.StringValue(i.Level),
+			Message: types.StringValue(i.Message),
+		}
+
+		if i.Error != nil {
+			item.Error = &entityStatusItemErrorModel{
+				Name:    types.StringValue(i.Error.Name),
+				Message: types.StringValue(i.Error.Message),
+			}
 		}
+
+		models = append(models, item)
 	}
 
-	diags := resp.State.Set(ctx, state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	return models
+}
+
+// addEntityStatusErrors adds an error to the diagnostics for each error level item of the entity status.
+func addEntityStatusErrors(diags *diag.Diagnostics, status *backstage.EntityStatus, subject string) {
+	if status == nil {
 		return
 	}
+
+	for _, i := range status.Items {
+		if i.Level != "error" {
+			continue
+		}
+
+		detail := fmt.Sprintf("Backstage %s has %s status error: %s", subject, i.Type, i.Message)
+		if i.Error != nil {
+			detail += fmt.Sprintf(" (%s: %s)", i.Error.Name, i.Error.Message)
+		}
+
+		diags.AddError("Backstage entity has processing errors", detail)
+	}
 }
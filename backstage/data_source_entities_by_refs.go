@@ -0,0 +1,206 @@
+package backstage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &entitiesByRefsDataSource{}
+	_ datasource.DataSourceWithConfigure = &entitiesByRefsDataSource{}
+)
+
+// NewEntitiesByRefsDataSource is a helper function to simplify the provider implementation.
+func NewEntitiesByRefsDataSource() datasource.DataSource {
+	return &entitiesByRefsDataSource{}
+}
+
+// entitiesByRefsDataSource is the data source implementation.
+type entitiesByRefsDataSource struct {
+	client *client.Client
+}
+
+type entitiesByRefsDataSourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Refs          []string       `tfsdk:"refs"`
+	FailOnMissing types.Bool     `tfsdk:"fail_on_missing"`
+	Entities      []*entityModel `tfsdk:"entities"`
+}
+
+const (
+	descriptionEntitiesByRefsID   = "Identifier of the entity references."
+	descriptionEntitiesByRefsRefs = "A list of references of the entities to get, in the `<kind>:[<namespace>/]<name>` format, e.g. " +
+		"`component:default/artist-web`. The namespace defaults to the one configured in the provider."
+	descriptionEntitiesByRefsFailOnMissing = "Whether to fail, if any of the entities is not found (default: `false`). Otherwise, missing " +
+		"entities are returned as `null`."
+	descriptionEntitiesByRefsEntities = "The entities in the order of `refs`, with `null` in place of the ones not found."
+)
+
+// Metadata returns the data source type name.
+func (d *entitiesByRefsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entities_by_refs"
+}
+
+// Schema defines the schema for the data source.
+func (d *entitiesByRefsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get multiple " +
+			"[entities](https://backstage.io/docs/features/software-catalog/descriptor-format#overall-shape-of-an-entity) of any kind from " +
+			"Backstage Software Catalog by their references, using a single request.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: descriptionEntitiesByRefsID},
+			"refs": schema.ListAttribute{Required: true, MarkdownDescription: descriptionEntitiesByRefsRefs, ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				}},
+			"fail_on_missing": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntitiesByRefsFailOnMissing},
+			"entities": schema.ListNestedAttribute{Computed: true, MarkdownDescription: descriptionEntitiesByRefsEntities, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"api_version": schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+					"spec":        schema.StringAttribute{Computed: true, Description: descriptionEntitySpecJson, CustomType: jsontypes.NormalizedType{}},
+					"kind":        schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
+					"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
+						"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
+						"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
+						"name":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataName},
+						"namespace":   schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataNamespace},
+						"title":       schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataTitle},
+						"description": schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataDescription},
+						"labels":      schema.MapAttribute{Computed: true, Description: descriptionEntityMetadataLabels, ElementType: types.StringType},
+						"annotations": schema.MapAttribute{Computed: true, Description: descriptionEntityMetadataAnnotations, ElementType: types.StringType},
+						"tags":        schema.ListAttribute{Computed: true, Description: descriptionEntityMetadataTags, ElementType: types.StringType},
+						"links": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityMetadataLinks, NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"url":   schema.StringAttribute{Computed: true, Description: descriptionEntityLinkURL},
+								"title": schema.StringAttribute{Computed: true, Description: descriptionEntityLinkTitle},
+								"icon":  schema.StringAttribute{Computed: true, Description: descriptionEntityLinkIco},
+								"type":  schema.StringAttribute{Computed: true, Description: descriptionEntityLinkType},
+							},
+						}},
+					}},
+					"relations": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityRelations, NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"type":       schema.StringAttribute{Computed: true, Description: descriptionEntityRelationType},
+							"target_ref": schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetRef},
+							"target": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityRelationTarget,
+								Attributes: map[string]schema.Attribute{
+									"name":      schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetName},
+									"kind":      schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetKind},
+									"namespace": schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetNamespace},
+								}},
+						},
+					}},
+					"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+							"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+							"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+							"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+								"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+								"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+							}},
+						},
+					}},
+				},
+			}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *entitiesByRefsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *entitiesByRefsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state entitiesByRefsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API matches complete references only, so the missing namespaces are filled in.
+	refs := make([]string, 0, len(state.Refs))
+	for i, r := range state.Refs {
+		ref, err := client.ParseEntityRef(r, "", d.client.DefaultNamespace)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("refs").AtListIndex(i), "Invalid entity reference", err.Error())
+			continue
+		}
+		refs = append(refs, ref.String())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Getting entities %v from Backstage API", refs))
+	entities, response, err := d.client.GetEntitiesByRefs(ctx, refs, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Backstage entities", fmt.Sprintf("Could not read Backstage entities %v: %s", state.Refs, err.Error()))
+		return
+	}
+
+	if response.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Error reading Backstage entities", fmt.Sprintf("Could not read Backstage entities %v: %s", state.Refs, response.Status))
+		return
+	}
+
+	if len(entities.Items) != len(state.Refs) {
+		resp.Diagnostics.AddError("Error reading Backstage entities", fmt.Sprintf(
+			"Could not read Backstage entities %v: expected %d entities, but got %d", state.Refs, len(state.Refs), len(entities.Items)))
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprint(state.Refs))
+	state.Entities = []*entityModel{}
+
+	for i, e := range entities.Items {
+		if e == nil {
+			if state.FailOnMissing.ValueBool() {
+				resp.Diagnostics.AddError("Backstage entity not found", fmt.Sprintf("Could not find Backstage entity %s", state.Refs[i]))
+			}
+			state.Entities = append(state.Entities, nil)
+			continue
+		}
+
+		entity, err := newEntityModel(e)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error parsing Backstage entity specs",
+				fmt.Sprintf("Could not parse Specs for Backstage entity %s: %s", state.Refs[i], err.Error()),
+			)
+			continue
+		}
+
+		state.Entities = append(state.Entities, &entity)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,88 @@
+package backstage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceEntitiesByRefs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceEntitiesByRefsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_entities_by_refs.test", "entities.#", "3"),
+					resource.TestCheckResourceAttr("data.backstage_entities_by_refs.test", "entities.0.metadata.name", "searcher"),
+					resource.TestCheckNoResourceAttr("data.backstage_entities_by_refs.test", "entities.1.metadata.name"),
+					resource.TestCheckResourceAttr("data.backstage_entities_by_refs.test", "entities.2.kind", "User"),
+					resource.TestCheckResourceAttr("data.backstage_entities_by_refs.test", "entities.2.metadata.name", "janelle.dawe"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig + testAccDataSourceEntitiesByRefsFailOnMissingConfig,
+				ExpectError: regexp.MustCompile("Backstage entity not found"),
+			},
+		},
+	})
+}
+
+func TestEntitiesByRefsDataSource_RefsNormalized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			EntityRefs []string `json:"entityRefs"`
+		}
+		assert.NoErrorf(t, json.NewDecoder(r.Body).Decode(&body), "Request body should be decoded")
+		assert.Equalf(t, []string{"component:default/artist-web", "user:custom/janelle.dawe"}, body.EntityRefs,
+			"Missing namespaces should be filled in")
+		_, _ = w.Write([]byte(`{"items":[null,null]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	d := &entitiesByRefsDataSource{client: c}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}
+	assert.Emptyf(t, config.Set(context.Background(), &entitiesByRefsDataSourceModel{
+		Refs: []string{"component:artist-web", "user:custom/janelle.dawe"}}), "Config should be set")
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &readResp)
+	assert.Emptyf(t, readResp.Diagnostics, "Read should not return diagnostics")
+
+	assert.Emptyf(t, config.Set(context.Background(), &entitiesByRefsDataSourceModel{Refs: []string{"artist-web"}}), "Config should be set")
+	readResp = datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &readResp)
+	assert.Truef(t, readResp.Diagnostics.HasError(), "Reference without kind should be rejected")
+}
+
+const testAccDataSourceEntitiesByRefsConfig = `
+data "backstage_entities_by_refs" "test" {
+  refs = [
+    "component:default/searcher",
+    "component:default/non-existent-component-a9ab8",
+    "user:janelle.dawe",
+  ]
+}
+`
+
+const testAccDataSourceEntitiesByRefsFailOnMissingConfig = `
+data "backstage_entities_by_refs" "test" {
+  refs            = ["component:default/non-existent-component-a9ab8"]
+  fail_on_missing = true
+}
+`
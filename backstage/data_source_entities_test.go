@@ -1,11 +1,21 @@
 package backstage
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-cty/cty/function/stdlib"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -58,3 +68,120 @@ data "backstage_entities" "test" {
   ]
 }
 `
+
+func TestAccDataSourceEntities_WithPagination(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceEntitiesWithPaginationConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_entities.test", "entities.#", "3"),
+					resource.TestCheckResourceAttrSet("data.backstage_entities.test", "entities.0.metadata.name"),
+					resource.TestCheckResourceAttr("data.backstage_entities.test", "entities.0.api_version", ""),
+					resource.TestCheckResourceAttrPair("data.backstage_entities.test", "entities.0.metadata.name",
+						"data.backstage_entities.test_first", "entities.0.metadata.name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceEntitiesWithPaginationConfig = `
+data "backstage_entities" "test" {
+  filters = ["kind=component"]
+  fields  = ["metadata.name", "spec.owner"]
+  order = [
+    { field = "metadata.name", direction = "desc" },
+  ]
+  limit = 3
+}
+
+data "backstage_entities" "test_first" {
+  filters = ["kind=component"]
+  order = [
+    { field = "metadata.name", direction = "desc" },
+  ]
+  limit = 1
+}
+`
+
+func TestAccDataSourceEntities_WithFullTextSearch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceEntitiesWithFullTextSearchConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_entities.test", "entities.#", "1"),
+					resource.TestCheckResourceAttr("data.backstage_entities.test", "entities.0.metadata.name", "searcher"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceEntitiesWithFullTextSearchConfig = `
+data "backstage_entities" "test" {
+  filters          = ["kind=component"]
+  full_text_term   = "searcher"
+  full_text_fields = ["metadata.description"]
+}
+`
+
+func TestAddEntityStatusErrors(t *testing.T) {
+	var diags diag.Diagnostics
+	addEntityStatusErrors(&diags, nil, "Component kind default/test")
+	assert.Emptyf(t, diags, "Missing status should not produce errors")
+
+	addEntityStatusErrors(&diags, &backstage.EntityStatus{Items: []backstage.EntityStatusItem{
+		{Type: "backstage.io/catalog-processing", Level: "warning", Message: "Deprecated field"},
+		{Type: "backstage.io/catalog-processing", Level: "error", Message: "Processing failed",
+			Error: &backstage.EntityStatusItemError{Name: "InputError", Message: "Invalid spec.owner"}},
+	}}, "Component kind default/test")
+	if assert.Lenf(t, diags, 1, "Only error level items should produce errors") {
+		assert.Equalf(t, diag.SeverityError, diags[0].Severity(), "Diagnostic should be an error")
+		assert.Equalf(t, "Backstage Component kind default/test has backstage.io/catalog-processing status error: Processing failed "+
+			"(InputError: Invalid spec.owner)", diags[0].Detail(), "Error should describe the status item")
+	}
+}
+
+// newUnreachableClient returns a client of a Backstage instance, which cannot be reached, as its server is already closed.
+func newUnreachableClient(t *testing.T) *client.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+
+	return c
+}
+
+// readDataSource reads the data source with the configuration given by the model and returns the response.
+func readDataSource(t *testing.T, d datasource.DataSource, model interface{}) datasource.ReadResponse {
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}
+	assert.Emptyf(t, config.Set(context.Background(), model), "Config should be set")
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	assert.NotPanicsf(t, func() {
+		d.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &readResp)
+	}, "Read should not panic")
+
+	return readResp
+}
+
+func TestEntitiesDataSource_FallbackOnTransportError(t *testing.T) {
+	readResp := readDataSource(t, &entityDataSource{client: newUnreachableClient(t)}, &entityDataSourceModel{
+		Filters:  []string{"kind=Component"},
+		Fallback: &entityFallbackModel{Filters: []string{"kind=Component"}, Entities: []entityModel{}},
+	})
+	assert.Falsef(t, readResp.Diagnostics.HasError(), "Read should not return errors, when fallback is set")
+	assert.Equalf(t, 1, readResp.Diagnostics.WarningsCount(), "Transport error should be reported as a warning")
+
+	var state entityDataSourceModel
+	readResp.State.Get(context.Background(), &state)
+	assert.Equalf(t, "123456789", state.ID.ValueString(), "Fallback should be used")
+}
@@ -0,0 +1,224 @@
+package backstage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &genericEntityDataSource{}
+	_ datasource.DataSourceWithConfigure = &genericEntityDataSource{}
+)
+
+// NewGenericEntityDataSource is a helper function to simplify the provider implementation.
+func NewGenericEntityDataSource() datasource.DataSource {
+	return &genericEntityDataSource{}
+}
+
+// genericEntityDataSource is the data source implementation.
+type genericEntityDataSource struct {
+	client *client.Client
+}
+
+type genericEntityDataSourceModel struct {
+	ID                     types.String            `tfsdk:"id"`
+	Ref                    types.String            `tfsdk:"ref"`
+	Kind                   types.String            `tfsdk:"kind"`
+	Name                   types.String            `tfsdk:"name"`
+	Namespace              types.String            `tfsdk:"namespace"`
+	ApiVersion             types.String            `tfsdk:"api_version"`
+	Metadata               *entityMetadataModel    `tfsdk:"metadata"`
+	Relations              []entityRelationModel   `tfsdk:"relations"`
+	Status                 []entityStatusItemModel `tfsdk:"status"`
+	Spec                   jsontypes.Normalized    `tfsdk:"spec"`
+	FailOnProcessingErrors types.Bool              `tfsdk:"fail_on_processing_errors"`
+}
+
+const (
+	patternEntityKind           = `^[a-zA-Z][a-zA-Z0-9]*$`
+	descriptionGenericEntityRef = "Reference of the entity in the `<kind>:[<namespace>/]<name>` format, e.g. `template:default/create-react-app`. " +
+		"Namespace defaults to `default` or the one set in the provider. Conflicts with `kind`, `name` and `namespace`."
+	descriptionGenericEntityKind      = "The high level entity type being described, e.g. `Template` or a custom kind. Required, if `ref` is not set."
+	descriptionGenericEntityName      = "Name of the entity. Required, if `ref` is not set."
+	descriptionGenericEntityNamespace = "Namespace that the entity belongs to (`default` or the one set in the provider, if not set)."
+)
+
+// Metadata returns the data source type name.
+func (d *genericEntityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity"
+}
+
+// Schema defines the schema for the data source.
+func (d *genericEntityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get a specific " +
+			"[entity](https://backstage.io/docs/features/software-catalog/descriptor-format#overall-shape-of-an-entity) of any kind, including " +
+			"`Template` and custom kinds, from Backstage Software Catalog.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
+			"ref": schema.StringAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionGenericEntityRef, Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				stringvalidator.ConflictsWith(path.MatchRoot("kind"), path.MatchRoot("namespace")),
+			}},
+			"kind": schema.StringAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionGenericEntityKind, Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(patternEntityKind), "must follow Backstage format restrictions"),
+				stringvalidator.AlsoRequires(path.MatchRoot("name")),
+			}},
+			"name": schema.StringAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionGenericEntityName, Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(patternEntityName),
+					"must follow Backstage format restrictions",
+				),
+				stringvalidator.AlsoRequires(path.MatchRoot("kind")),
+			}},
+			"namespace": schema.StringAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionGenericEntityNamespace, Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(patternEntityName),
+					"must follow Backstage format restrictions",
+				),
+			}},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntityFailOnProcessingErrors},
+			"api_version":               schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+			"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
+				"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
+				"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
+				"name":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataName},
+				"namespace":   schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataNamespace},
+				"title":       schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataTitle},
+				"description": schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataDescription},
+				"labels":      schema.MapAttribute{Computed: true, Description: descriptionEntityMetadataLabels, ElementType: types.StringType},
+				"annotations": schema.MapAttribute{Computed: true, Description: descriptionEntityMetadataAnnotations, ElementType: types.StringType},
+				"tags":        schema.ListAttribute{Computed: true, Description: descriptionEntityMetadataTags, ElementType: types.StringType},
+				"links": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityMetadataLinks, NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url":   schema.StringAttribute{Computed: true, Description: descriptionEntityLinkURL},
+						"title": schema.StringAttribute{Computed: true, Description: descriptionEntityLinkTitle},
+						"icon":  schema.StringAttribute{Computed: true, Description: descriptionEntityLinkIco},
+						"type":  schema.StringAttribute{Computed: true, Description: descriptionEntityLinkType},
+					},
+				}},
+			}},
+			"relations": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityRelations, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":       schema.StringAttribute{Computed: true, Description: descriptionEntityRelationType},
+					"target_ref": schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetRef},
+					"target": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityRelationTarget,
+						Attributes: map[string]schema.Attribute{
+							"name":      schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetName},
+							"kind":      schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetKind},
+							"namespace": schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetNamespace},
+						}},
+				},
+			}},
+			"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+					"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+					"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+						"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+					}},
+				},
+			}},
+			"spec": schema.StringAttribute{Computed: true, Description: descriptionEntitySpecJson, CustomType: jsontypes.NormalizedType{}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *genericEntityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *genericEntityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state genericEntityDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ref := client.EntityRef{Kind: state.Kind.ValueString(), Namespace: state.Namespace.ValueString(), Name: state.Name.ValueString()}
+	if ref.Namespace == "" {
+		ref.Namespace = d.client.DefaultNamespace
+	}
+	if !state.Ref.IsNull() {
+		var err error
+		if ref, err = client.ParseEntityRef(state.Ref.ValueString(), "", d.client.DefaultNamespace); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ref"), "Invalid entity reference", err.Error())
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Getting entity %s from Backstage API", ref))
+	entity, response, err := d.client.GetEntityByName(ctx, ref)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Backstage entity", fmt.Sprintf("Could not read Backstage entity %s: %s", ref, err.Error()))
+		return
+	}
+
+	if response.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Error reading Backstage entity", fmt.Sprintf("Could not read Backstage entity %s: %s", ref, response.Status))
+		return
+	}
+
+	addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("entity %s", ref))
+
+	if state.FailOnProcessingErrors.ValueBool() {
+		addEntityStatusErrors(&resp.Diagnostics, entity.Status, fmt.Sprintf("entity %s", ref))
+	}
+
+	spec, err := json.Marshal(entity.Spec)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing Backstage entity specs",
+			fmt.Sprintf("Could not parse Specs for Backstage entity %s: %s", ref, err.Error()))
+		return
+	}
+
+	state.ID = types.StringValue(entity.Metadata.UID)
+	if state.Ref.IsNull() {
+		state.Ref = types.StringValue(ref.String())
+	}
+	if state.Kind.IsNull() {
+		state.Kind = types.StringValue(entity.Kind)
+	}
+	if state.Name.IsNull() {
+		state.Name = types.StringValue(entity.Metadata.Name)
+	}
+	if state.Namespace.IsNull() {
+		state.Namespace = types.StringValue(entity.Metadata.Namespace)
+	}
+	state.ApiVersion = types.StringValue(entity.ApiVersion)
+	state.Metadata = newEntityMetadataModel(&entity.Metadata)
+	state.Relations = newEntityRelationModels(entity.Relations)
+	state.Status = newEntityStatusItemModels(entity.Status)
+	state.Spec = jsontypes.NewNormalizedValue(string(spec))
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,230 @@
+package backstage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &entityAncestryDataSource{}
+	_ datasource.DataSourceWithConfigure = &entityAncestryDataSource{}
+)
+
+// NewEntityAncestryDataSource is a helper function to simplify the provider implementation.
+func NewEntityAncestryDataSource() datasource.DataSource {
+	return &entityAncestryDataSource{}
+}
+
+// entityAncestryDataSource is the data source implementation.
+type entityAncestryDataSource struct {
+	client *client.Client
+}
+
+type entityAncestryDataSourceModel struct {
+	ID         types.String          `tfsdk:"id"`
+	Kind       types.String          `tfsdk:"kind"`
+	Name       types.String          `tfsdk:"name"`
+	Namespace  types.String          `tfsdk:"namespace"`
+	RootRef    types.String          `tfsdk:"root_ref"`
+	OriginRefs []types.String        `tfsdk:"origin_refs"`
+	Ancestors  []entityAncestorModel `tfsdk:"ancestors"`
+}
+
+type entityAncestorModel struct {
+	Ref        types.String   `tfsdk:"ref"`
+	ParentRefs []types.String `tfsdk:"parent_refs"`
+	Entity     *entityModel   `tfsdk:"entity"`
+}
+
+const (
+	descriptionEntityAncestryKind       = "The high level entity type being described, e.g. `Component`."
+	descriptionEntityAncestryRootRef    = "Reference of the entity the ancestry is returned for."
+	descriptionEntityAncestryOriginRefs = "References of the top-most ancestors, which have no parents, e.g. the root locations that " +
+		"(indirectly) emitted the entity."
+	descriptionEntityAncestryAncestors  = "The entity itself and all its ancestors, e.g. the locations that emitted it."
+	descriptionEntityAncestorRef        = "Reference of the entity."
+	descriptionEntityAncestorParentRefs = "References of the entities that emitted the entity."
+	descriptionEntityAncestorEntity     = "The entity itself."
+)
+
+// Metadata returns the data source type name.
+func (d *entityAncestryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_ancestry"
+}
+
+// Schema defines the schema for the data source.
+func (d *entityAncestryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the ancestry of a specific " +
+			"[entity](https://backstage.io/docs/features/software-catalog/descriptor-format#overall-shape-of-an-entity) from Backstage Software " +
+			"Catalog, i.e. the tree of locations and other entities that emitted it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
+			"kind": schema.StringAttribute{Required: true, MarkdownDescription: descriptionEntityAncestryKind, Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(patternEntityKind), "must follow Backstage format restrictions"),
+			}},
+			"name": schema.StringAttribute{Required: true, Description: descriptionEntityMetadataName, Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(patternEntityName),
+					"must follow Backstage format restrictions",
+				),
+			}},
+			"namespace": schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataNamespace, Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(patternEntityName),
+					"must follow Backstage format restrictions",
+				),
+			}},
+			"root_ref":    schema.StringAttribute{Computed: true, Description: descriptionEntityAncestryRootRef},
+			"origin_refs": schema.ListAttribute{Computed: true, Description: descriptionEntityAncestryOriginRefs, ElementType: types.StringType},
+			"ancestors": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityAncestryAncestors, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"ref":         schema.StringAttribute{Computed: true, Description: descriptionEntityAncestorRef},
+					"parent_refs": schema.ListAttribute{Computed: true, Description: descriptionEntityAncestorParentRefs, ElementType: types.StringType},
+					"entity": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityAncestorEntity, Attributes: map[string]schema.Attribute{
+						"api_version": schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+						"spec":        schema.StringAttribute{Computed: true, Description: descriptionEntitySpecJson, CustomType: jsontypes.NormalizedType{}},
+						"kind":        schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
+						"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
+							"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
+							"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
+							"name":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataName},
+							"namespace":   schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataNamespace},
+							"title":       schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataTitle},
+							"description": schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataDescription},
+							"labels":      schema.MapAttribute{Computed: true, Description: descriptionEntityMetadataLabels, ElementType: types.StringType},
+							"annotations": schema.MapAttribute{Computed: true, Description: descriptionEntityMetadataAnnotations, ElementType: types.StringType},
+							"tags":        schema.ListAttribute{Computed: true, Description: descriptionEntityMetadataTags, ElementType: types.StringType},
+							"links": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityMetadataLinks, NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"url":   schema.StringAttribute{Computed: true, Description: descriptionEntityLinkURL},
+									"title": schema.StringAttribute{Computed: true, Description: descriptionEntityLinkTitle},
+									"icon":  schema.StringAttribute{Computed: true, Description: descriptionEntityLinkIco},
+									"type":  schema.StringAttribute{Computed: true, Description: descriptionEntityLinkType},
+								},
+							}},
+						}},
+						"relations": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityRelations, NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type":       schema.StringAttribute{Computed: true, Description: descriptionEntityRelationType},
+								"target_ref": schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetRef},
+								"target": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityRelationTarget,
+									Attributes: map[string]schema.Attribute{
+										"name":      schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetName},
+										"kind":      schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetKind},
+										"namespace": schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetNamespace},
+									}},
+							},
+						}},
+						"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+								"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+								"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+								"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+									"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+									"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+								}},
+							},
+						}},
+					}},
+				},
+			}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *entityAncestryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *entityAncestryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state entityAncestryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Namespace.IsNull() {
+		state.Namespace = types.StringValue(d.client.DefaultNamespace)
+	}
+
+	ref := client.EntityRef{Kind: state.Kind.ValueString(), Namespace: state.Namespace.ValueString(), Name: state.Name.ValueString()}
+
+	tflog.Debug(ctx, fmt.Sprintf("Getting ancestry of entity %s from Backstage API", ref))
+	ancestry, response, err := d.client.GetEntityAncestry(ctx, ref)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Backstage entity ancestry",
+			fmt.Sprintf("Could not read ancestry of Backstage entity %s: %s", ref, err.Error()))
+		return
+	}
+
+	if response.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Error reading Backstage entity ancestry",
+			fmt.Sprintf("Could not read ancestry of Backstage entity %s: %s", ref, response.Status))
+		return
+	}
+
+	addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("ancestry of entity %s", ref))
+
+	state.RootRef = types.StringValue(ancestry.RootEntityRef)
+	state.OriginRefs = []types.String{}
+	state.Ancestors = []entityAncestorModel{}
+
+	for _, i := range ancestry.Items {
+		entityRef := client.EntityRef{Kind: i.Entity.Kind, Namespace: i.Entity.Metadata.Namespace, Name: i.Entity.Metadata.Name}.String()
+		if entityRef == ancestry.RootEntityRef {
+			state.ID = types.StringValue(i.Entity.Metadata.UID)
+		}
+
+		entity, err := newEntityModel(&i.Entity)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error parsing Backstage entity specs",
+				fmt.Sprintf("Could not parse Specs for Backstage entity %s: %s", entityRef, err.Error()),
+			)
+			continue
+		}
+
+		ancestor := entityAncestorModel{Ref: types.StringValue(entityRef), ParentRefs: []types.String{}, Entity: &entity}
+		for _, p := range i.ParentEntityRefs {
+			ancestor.ParentRefs = append(ancestor.ParentRefs, types.StringValue(p))
+		}
+
+		if len(i.ParentEntityRefs) == 0 {
+			state.OriginRefs = append(state.OriginRefs, types.StringValue(entityRef))
+		}
+
+		state.Ancestors = append(state.Ancestors, ancestor)
+	}
+
+	if state.ID.IsNull() {
+		state.ID = state.RootRef
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,56 @@
+package backstage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceEntityAncestry(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceEntityAncestryConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_entity_ancestry.test", "root_ref", "component:default/shuffle-api"),
+					resource.TestCheckResourceAttr("data.backstage_entity_ancestry.test", "ancestors.0.ref", "component:default/shuffle-api"),
+					resource.TestCheckResourceAttr("data.backstage_entity_ancestry.test", "ancestors.0.entity.metadata.name", "shuffle-api"),
+					resource.TestCheckResourceAttrSet("data.backstage_entity_ancestry.test", "ancestors.0.parent_refs.0"),
+					resource.TestCheckResourceAttr("data.backstage_entity_ancestry.test", "ancestors.1.entity.kind", "Location"),
+					resource.TestCheckResourceAttrSet("data.backstage_entity_ancestry.test", "origin_refs.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceEntityAncestryConfig = `
+data "backstage_entity_ancestry" "test" {
+  kind = "Component"
+  name = "shuffle-api"
+}
+`
+
+func TestEntityAncestryDataSource_ProviderDefaultNamespace(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	c.DefaultNamespace = "team-a"
+
+	readDataSource(t, &entityAncestryDataSource{client: c}, &entityAncestryDataSourceModel{Kind: types.StringValue("component"),
+		Name: types.StringValue("artist-web")})
+	assert.Equalf(t, "/api/catalog/entities/by-name/component/team-a/artist-web/ancestry", path,
+		"Namespace should default to the one configured in the provider")
+}
@@ -0,0 +1,137 @@
+package backstage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &entityFacetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &entityFacetsDataSource{}
+)
+
+// NewEntityFacetsDataSource is a helper function to simplify the provider implementation.
+func NewEntityFacetsDataSource() datasource.DataSource {
+	return &entityFacetsDataSource{}
+}
+
+// entityFacetsDataSource is the data source implementation.
+type entityFacetsDataSource struct {
+	client *client.Client
+}
+
+type entityFacetsDataSourceModel struct {
+	ID      types.String                       `tfsdk:"id"`
+	Facets  []string                           `tfsdk:"facets"`
+	Filters []string                           `tfsdk:"filters"`
+	Values  map[string][]entityFacetValueModel `tfsdk:"values"`
+}
+
+type entityFacetValueModel struct {
+	Value types.String `tfsdk:"value"`
+	Count types.Int64  `tfsdk:"count"`
+}
+
+const (
+	descriptionEntityFacetsID      = "Identifier of the facets and filters."
+	descriptionEntityFacetsFacets  = "A set of fields to get the distinct values of, e.g. `spec.type`, `spec.lifecycle` or `metadata.tags`."
+	descriptionEntityFacetsFilters = "A set of conditions that can be used to filter entities, in the same format as in `backstage_entities`. " +
+		"All entities are considered, if not set."
+	descriptionEntityFacetsValues = "Distinct values of each facet, along with the number of entities having them."
+	descriptionEntityFacetValue   = "Distinct value of the facet."
+	descriptionEntityFacetCount   = "Number of entities having the value."
+)
+
+// Metadata returns the data source type name.
+func (d *entityFacetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_facets"
+}
+
+// Schema defines the schema for the data source.
+func (d *entityFacetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the distinct values of entity fields (facets) and their counts from Backstage Software " +
+			"Catalog. For more information about the way filters are defined and applied, see " +
+			"[Backstage documentation](https://backstage.io/docs/features/software-catalog/software-catalog-api#filtering).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: descriptionEntityFacetsID},
+			"facets": schema.ListAttribute{Required: true, MarkdownDescription: descriptionEntityFacetsFacets, ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				}},
+			"filters": schema.ListAttribute{Optional: true, MarkdownDescription: descriptionEntityFacetsFilters, ElementType: types.StringType},
+			"values": schema.MapAttribute{Computed: true, Description: descriptionEntityFacetsValues, ElementType: types.ListType{
+				ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"value": types.StringType,
+					"count": types.Int64Type,
+				}},
+			}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *entityFacetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *entityFacetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state entityFacetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Getting entity facets %v for filters %v from Backstage API", state.Facets, state.Filters))
+	facets, response, err := d.client.GetEntityFacets(ctx, &client.EntityFacetsOptions{Facets: state.Facets, Filters: state.Filters})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Backstage entity facets",
+			fmt.Sprintf("Could not read Backstage entity facets %v: %s", state.Facets, err.Error()))
+		return
+	}
+
+	if response.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Error reading Backstage entity facets",
+			fmt.Sprintf("Could not read Backstage entity facets %v: %s", state.Facets, response.Status))
+		return
+	}
+
+	addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("entity facets %v", state.Facets))
+
+	state.ID = types.StringValue(fmt.Sprint(state.Facets, state.Filters))
+	state.Values = map[string][]entityFacetValueModel{}
+	for _, f := range state.Facets {
+		values := []entityFacetValueModel{}
+		for _, v := range facets.Facets[f] {
+			values = append(values, entityFacetValueModel{
+				Value: types.StringValue(v.Value),
+				Count: types.Int64Value(int64(v.Count)),
+			})
+		}
+		state.Values[f] = values
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,30 @@
+package backstage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEntityFacets(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceEntityFacetsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_entity_facets.test", "values.%", "2"),
+					resource.TestCheckResourceAttrSet("data.backstage_entity_facets.test", "values.spec.type.0.value"),
+					resource.TestCheckResourceAttrSet("data.backstage_entity_facets.test", "values.spec.lifecycle.0.count"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceEntityFacetsConfig = `
+data "backstage_entity_facets" "test" {
+  facets  = ["spec.type", "spec.lifecycle"]
+  filters = ["kind=component"]
+}
+`
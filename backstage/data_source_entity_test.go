@@ -0,0 +1,41 @@
+package backstage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEntity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceEntityConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_entity.test", "api_version", "backstage.io/v1alpha1"),
+					resource.TestCheckResourceAttr("data.backstage_entity.test", "kind", "Component"),
+					resource.TestCheckResourceAttr("data.backstage_entity.test", "namespace", "default"),
+					resource.TestCheckResourceAttr("data.backstage_entity.test", "ref", "component:default/shuffle-api"),
+					resource.TestCheckResourceAttr("data.backstage_entity.test", "metadata.description", "Shuffle API"),
+					resource.TestCheckResourceAttr("data.backstage_entity.test", "relations.0.target_ref", "user:default/guest"),
+					resource.TestCheckResourceAttrSet("data.backstage_entity.test", "spec"),
+					resource.TestCheckResourceAttr("data.backstage_entity.test_ref", "kind", "Template"),
+					resource.TestCheckResourceAttr("data.backstage_entity.test_ref", "name", "react-ssr-template"),
+					resource.TestCheckResourceAttr("data.backstage_entity.test_ref", "api_version", "scaffolder.backstage.io/v1beta3"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceEntityConfig = `
+data "backstage_entity" "test" {
+  kind = "Component"
+  name = "shuffle-api"
+}
+
+data "backstage_entity" "test_ref" {
+  ref = "template:default/react-ssr-template"
+}
+`
@@ -0,0 +1,137 @@
+package backstage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &entityValidationDataSource{}
+	_ datasource.DataSourceWithConfigure = &entityValidationDataSource{}
+)
+
+// NewEntityValidationDataSource is a helper function to simplify the provider implementation.
+func NewEntityValidationDataSource() datasource.DataSource {
+	return &entityValidationDataSource{}
+}
+
+// entityValidationDataSource is the data source implementation.
+type entityValidationDataSource struct {
+	client *client.Client
+}
+
+type entityValidationDataSourceModel struct {
+	ID       types.String                 `tfsdk:"id"`
+	Entity   jsontypes.Normalized         `tfsdk:"entity"`
+	Location types.String                 `tfsdk:"location"`
+	Valid    types.Bool                   `tfsdk:"valid"`
+	Errors   []entityValidationErrorModel `tfsdk:"errors"`
+}
+
+type entityValidationErrorModel struct {
+	Name    types.String `tfsdk:"name"`
+	Message types.String `tfsdk:"message"`
+}
+
+const (
+	patternLocationRef = `^[a-zA-Z0-9-]+:.+$`
+
+	descriptionEntityValidationID       = "Identifier of the validated location."
+	descriptionEntityValidationEntity   = "Entity descriptor to validate (as JSON), e.g. `jsonencode(yamldecode(file(\"catalog-info.yaml\")))`."
+	descriptionEntityValidationLocation = "Reference of the location the entity is going to be read from, in the `<type>:<target>` format, e.g. " +
+		"`url:https://github.com/example/repo/blob/main/catalog-info.yaml`. Processors of the catalog may use it to validate the entity."
+	descriptionEntityValidationValid        = "Whether the entity is valid, i.e. there are no `errors`."
+	descriptionEntityValidationErrors       = "Problems found in the entity."
+	descriptionEntityValidationErrorName    = "Type name of the error, e.g. `InputError`."
+	descriptionEntityValidationErrorMessage = "Message describing the problem."
+)
+
+// Metadata returns the data source type name.
+func (d *entityValidationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_validation"
+}
+
+// Schema defines the schema for the data source.
+func (d *entityValidationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to validate an [entity descriptor](https://backstage.io/docs/features/software-catalog/descriptor-format) " +
+			"with Backstage Software Catalog, without registering it. Combined with a `precondition`, it fails the plan early, e.g. when " +
+			"generating `catalog-info.yaml` files with Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: descriptionEntityValidationID},
+			"entity": schema.StringAttribute{Required: true, CustomType: jsontypes.NormalizedType{},
+				MarkdownDescription: descriptionEntityValidationEntity},
+			"location": schema.StringAttribute{Required: true, MarkdownDescription: descriptionEntityValidationLocation, Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(patternLocationRef), "must be in the <type>:<target> format"),
+			}},
+			"valid": schema.BoolAttribute{Computed: true, MarkdownDescription: descriptionEntityValidationValid},
+			"errors": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityValidationErrors, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityValidationErrorName},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionEntityValidationErrorMessage},
+				},
+			}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *entityValidationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *entityValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state entityValidationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Validating entity from location %s with Backstage API", state.Location.ValueString()))
+	validation, response, err := d.client.ValidateEntity(ctx, json.RawMessage(state.Entity.ValueString()), state.Location.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error validating Backstage entity",
+			fmt.Sprintf("Could not validate Backstage entity from location %s: %s", state.Location.ValueString(), err.Error()))
+		return
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusBadRequest {
+		resp.Diagnostics.AddError("Error validating Backstage entity",
+			fmt.Sprintf("Could not validate Backstage entity from location %s: %s", state.Location.ValueString(), response.Status))
+		return
+	}
+
+	state.ID = state.Location
+	state.Valid = types.BoolValue(len(validation.Errors) == 0)
+	state.Errors = []entityValidationErrorModel{}
+	for _, e := range validation.Errors {
+		state.Errors = append(state.Errors, entityValidationErrorModel{
+			Name:    types.StringValue(e.Name),
+			Message: types.StringValue(e.Message),
+		})
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,45 @@
+package backstage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEntityValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceEntityValidationConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_entity_validation.valid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.backstage_entity_validation.valid", "errors.#", "0"),
+					resource.TestCheckResourceAttr("data.backstage_entity_validation.invalid", "valid", "false"),
+					resource.TestCheckResourceAttrSet("data.backstage_entity_validation.invalid", "errors.0.message"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceEntityValidationConfig = `
+data "backstage_entity_validation" "valid" {
+  entity = jsonencode({
+    apiVersion = "backstage.io/v1alpha1"
+    kind       = "Component"
+    metadata   = { name = "validated-component" }
+    spec       = { type = "service", lifecycle = "production", owner = "guest" }
+  })
+  location = "url:https://github.com/example/repo/blob/main/catalog-info.yaml"
+}
+
+data "backstage_entity_validation" "invalid" {
+  entity = jsonencode({
+    apiVersion = "backstage.io/v1alpha1"
+    kind       = "Component"
+    metadata   = { name = "invalid name!" }
+  })
+  location = "url:https://github.com/example/repo/blob/main/catalog-info.yaml"
+}
+`
@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,19 +28,21 @@ func NewGroupDataSource() datasource.DataSource {
 
 // groupDataSource is the data source implementation.
 type groupDataSource struct {
-	client *backstage.Client
+	client *client.Client
 }
 
 type groupDataSourceModel struct {
-	ID         types.String          `tfsdk:"id"`
-	Name       types.String          `tfsdk:"name"`
-	Namespace  types.String          `tfsdk:"namespace"`
-	ApiVersion types.String          `tfsdk:"api_version"`
-	Kind       types.String          `tfsdk:"kind"`
-	Metadata   *entityMetadataModel  `tfsdk:"metadata"`
-	Relations  []entityRelationModel `tfsdk:"relations"`
-	Spec       *groupSpecModel       `tfsdk:"spec"`
-	Fallback   *groupFallbackModel   `tfsdk:"fallback"`
+	ID                     types.String            `tfsdk:"id"`
+	Name                   types.String            `tfsdk:"name"`
+	Namespace              types.String            `tfsdk:"namespace"`
+	ApiVersion             types.String            `tfsdk:"api_version"`
+	Kind                   types.String            `tfsdk:"kind"`
+	Metadata               *entityMetadataModel    `tfsdk:"metadata"`
+	Relations              []entityRelationModel   `tfsdk:"relations"`
+	Status                 []entityStatusItemModel `tfsdk:"status"`
+	Spec                   *groupSpecModel         `tfsdk:"spec"`
+	FailOnProcessingErrors types.Bool              `tfsdk:"fail_on_processing_errors"`
+	Fallback               *groupFallbackModel     `tfsdk:"fallback"`
 }
 
 type groupSpecModel struct {
@@ -105,8 +108,9 @@ func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					"must follow Backstage format restrictions",
 				),
 			}},
-			"api_version": schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
-			"kind":        schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntityFailOnProcessingErrors},
+			"api_version":               schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+			"kind":                      schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
 			"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
 				"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
 				"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
@@ -138,6 +142,17 @@ func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 						}},
 				},
 			}},
+			"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+					"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+					"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+						"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+					}},
+				},
+			}},
 			"spec": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntitySpec, Attributes: map[string]schema.Attribute{
 				"type":     schema.StringAttribute{Computed: true, Description: descriptionGroupType},
 				"parent":   schema.StringAttribute{Computed: true, Description: descriptionGroupSpecParent},
@@ -220,7 +235,7 @@ func (d *groupDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	d.client = req.ProviderData.(*backstage.Client)
+	d.client = req.ProviderData.(*client.Client)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -248,7 +263,7 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		resp.Diagnostics.AddWarning(shortErr, longErr)
 	}
 
-	if response.StatusCode != http.StatusOK {
+	if err == nil && response.StatusCode != http.StatusOK {
 		const shortErr = "Error reading Backstage Group kind"
 		longErr := fmt.Sprintf("Could not read Backstage Group kind %s/%s: %s", state.Namespace.ValueString(), state.Name.ValueString(), response.Status)
 		if state.Fallback == nil {
@@ -278,6 +293,12 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	if err == nil && response.StatusCode == http.StatusOK {
+		addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("Group kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+
+		state.Status = newEntityStatusItemModels(group.Status)
+		if state.FailOnProcessingErrors.ValueBool() {
+			addEntityStatusErrors(&resp.Diagnostics, group.Status, fmt.Sprintf("Group kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+		}
 
 		state.ID = types.StringValue(group.Metadata.UID)
 		state.ApiVersion = types.StringValue(group.ApiVersion)
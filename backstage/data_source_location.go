@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,19 +28,21 @@ func NewLocationDataSource() datasource.DataSource {
 
 // locationDataSource is the data source implementation.
 type locationDataSource struct {
-	client *backstage.Client
+	client *client.Client
 }
 
 type locationDataSourceModel struct {
-	ID         types.String           `tfsdk:"id"`
-	Name       types.String           `tfsdk:"name"`
-	Namespace  types.String           `tfsdk:"namespace"`
-	ApiVersion types.String           `tfsdk:"api_version"`
-	Kind       types.String           `tfsdk:"kind"`
-	Metadata   *entityMetadataModel   `tfsdk:"metadata"`
-	Relations  []entityRelationModel  `tfsdk:"relations"`
-	Spec       *locationSpecModel     `tfsdk:"spec"`
-	Fallback   *locationFallbackModel `tfsdk:"fallback"`
+	ID                     types.String            `tfsdk:"id"`
+	Name                   types.String            `tfsdk:"name"`
+	Namespace              types.String            `tfsdk:"namespace"`
+	ApiVersion             types.String            `tfsdk:"api_version"`
+	Kind                   types.String            `tfsdk:"kind"`
+	Metadata               *entityMetadataModel    `tfsdk:"metadata"`
+	Relations              []entityRelationModel   `tfsdk:"relations"`
+	Status                 []entityStatusItemModel `tfsdk:"status"`
+	Spec                   *locationSpecModel      `tfsdk:"spec"`
+	FailOnProcessingErrors types.Bool              `tfsdk:"fail_on_processing_errors"`
+	Fallback               *locationFallbackModel  `tfsdk:"fallback"`
 }
 
 type locationSpecModel struct {
@@ -97,8 +100,9 @@ func (d *locationDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 					"must follow Backstage format restrictions",
 				),
 			}},
-			"api_version": schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
-			"kind":        schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntityFailOnProcessingErrors},
+			"api_version":               schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+			"kind":                      schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
 			"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
 				"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
 				"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
@@ -130,6 +134,17 @@ func (d *locationDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 						}},
 				},
 			}},
+			"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+					"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+					"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+						"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+					}},
+				},
+			}},
 			"spec": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntitySpec, Attributes: map[string]schema.Attribute{
 				"type":     schema.StringAttribute{Computed: true, Description: descriptionLocationSpecType},
 				"target":   schema.StringAttribute{Computed: true, Description: descriptionLocationSpecTarget},
@@ -202,7 +217,7 @@ func (d *locationDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = req.ProviderData.(*backstage.Client)
+	d.client = req.ProviderData.(*client.Client)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -230,7 +245,7 @@ func (d *locationDataSource) Read(ctx context.Context, req datasource.ReadReques
 		resp.Diagnostics.AddWarning(shortErr, longErr)
 	}
 
-	if response.StatusCode != http.StatusOK {
+	if err == nil && response.StatusCode != http.StatusOK {
 		const shortErr = "Error reading Backstage Location kind"
 		longErr := fmt.Sprintf("Could not read Backstage Location kind %s/%s: %s", state.Namespace.ValueString(), state.Name.ValueString(), response.Status)
 		if state.Fallback == nil {
@@ -260,6 +275,13 @@ func (d *locationDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	if err == nil && response.StatusCode == http.StatusOK {
+		addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("Location kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+
+		state.Status = newEntityStatusItemModels(location.Status)
+		if state.FailOnProcessingErrors.ValueBool() {
+			addEntityStatusErrors(&resp.Diagnostics, location.Status, fmt.Sprintf("Location kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+		}
+
 		state.ID = types.StringValue(location.Metadata.UID)
 		state.ApiVersion = types.StringValue(location.ApiVersion)
 		state.Kind = types.StringValue(location.Kind)
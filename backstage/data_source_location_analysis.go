@@ -0,0 +1,228 @@
+package backstage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &locationAnalysisDataSource{}
+	_ datasource.DataSourceWithConfigure = &locationAnalysisDataSource{}
+)
+
+// NewLocationAnalysisDataSource is a helper function to simplify the provider implementation.
+func NewLocationAnalysisDataSource() datasource.DataSource {
+	return &locationAnalysisDataSource{}
+}
+
+// locationAnalysisDataSource is the data source implementation.
+type locationAnalysisDataSource struct {
+	client *client.Client
+}
+
+type locationAnalysisDataSourceModel struct {
+	ID                types.String                           `tfsdk:"id"`
+	Type              types.String                           `tfsdk:"type"`
+	Target            types.String                           `tfsdk:"target"`
+	CatalogFilename   types.String                           `tfsdk:"catalog_filename"`
+	ExistingEntities  []locationAnalysisExistingEntityModel  `tfsdk:"existing_entities"`
+	GeneratedEntities []locationAnalysisGeneratedEntityModel `tfsdk:"generated_entities"`
+}
+
+type locationAnalysisExistingEntityModel struct {
+	Ref            types.String         `tfsdk:"ref"`
+	Entity         jsontypes.Normalized `tfsdk:"entity"`
+	LocationType   types.String         `tfsdk:"location_type"`
+	LocationTarget types.String         `tfsdk:"location_target"`
+	IsRegistered   types.Bool           `tfsdk:"is_registered"`
+}
+
+type locationAnalysisGeneratedEntityModel struct {
+	Entity jsontypes.Normalized                  `tfsdk:"entity"`
+	Fields []locationAnalysisGeneratedFieldModel `tfsdk:"fields"`
+}
+
+type locationAnalysisGeneratedFieldModel struct {
+	Field       types.String `tfsdk:"field"`
+	State       types.String `tfsdk:"state"`
+	Value       types.String `tfsdk:"value"`
+	Description types.String `tfsdk:"description"`
+}
+
+const (
+	defaultLocationType = "url"
+
+	descriptionLocationAnalysisID              = "Identifier of the analyzed location."
+	descriptionLocationAnalysisType            = "Type of the location (default: `url`)."
+	descriptionLocationAnalysisTarget          = "Target of the location, e.g. URL of a repository or a `catalog-info.yaml` file."
+	descriptionLocationAnalysisCatalogFilename = "Name of the catalog file to look for in the location (default: the one configured in Backstage)."
+	descriptionLocationAnalysisExisting        = "Entities read from the catalog files, which already exist in the location."
+	descriptionLocationAnalysisExistingRef     = "Reference of the entity in the `<kind>:<namespace>/<name>` format."
+	descriptionLocationAnalysisExistingEntity  = "The entity (as JSON)."
+	descriptionLocationAnalysisExistingLocType = "Type of the location of the catalog file."
+	descriptionLocationAnalysisExistingLocTgt  = "Target of the location of the catalog file."
+	descriptionLocationAnalysisExistingIsReg   = "Whether the catalog file is already registered in the catalog."
+	descriptionLocationAnalysisGenerated       = "Entities, which Backstage suggests to generate for the location, as it has no catalog files."
+	descriptionLocationAnalysisGeneratedEntity = "The partial entity, as suggested by the analysis (as JSON)."
+	descriptionLocationAnalysisGeneratedFields = "Fields of the entity, along with the way their values were determined."
+	descriptionLocationAnalysisFieldField      = "Path of the field in the entity, e.g. `spec.owner`."
+	descriptionLocationAnalysisFieldState      = "The way the value was determined: `analysisSuggestedValue`, `analysisSuggestedNoValue` or `needsUserInput`."
+	descriptionLocationAnalysisFieldValue      = "Value suggested by the analysis, if any."
+	descriptionLocationAnalysisFieldDesc       = "Description of the field."
+)
+
+// Metadata returns the data source type name.
+func (d *locationAnalysisDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location_analysis"
+}
+
+// Schema defines the schema for the data source.
+func (d *locationAnalysisDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to analyze a location, before registering it in Backstage Software Catalog. It returns the " +
+			"entities, which would be discovered in the location: the ones defined in existing catalog files, or the ones Backstage suggests " +
+			"to generate.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: descriptionLocationAnalysisID},
+			"type": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionLocationAnalysisType, Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			}},
+			"target": schema.StringAttribute{Required: true, MarkdownDescription: descriptionLocationAnalysisTarget, Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			}},
+			"catalog_filename": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionLocationAnalysisCatalogFilename},
+			"existing_entities": schema.ListNestedAttribute{Computed: true, Description: descriptionLocationAnalysisExisting,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ref":             schema.StringAttribute{Computed: true, MarkdownDescription: descriptionLocationAnalysisExistingRef},
+						"entity":          schema.StringAttribute{Computed: true, CustomType: jsontypes.NormalizedType{}, Description: descriptionLocationAnalysisExistingEntity},
+						"location_type":   schema.StringAttribute{Computed: true, Description: descriptionLocationAnalysisExistingLocType},
+						"location_target": schema.StringAttribute{Computed: true, Description: descriptionLocationAnalysisExistingLocTgt},
+						"is_registered":   schema.BoolAttribute{Computed: true, Description: descriptionLocationAnalysisExistingIsReg},
+					},
+				}},
+			"generated_entities": schema.ListNestedAttribute{Computed: true, Description: descriptionLocationAnalysisGenerated,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entity": schema.StringAttribute{Computed: true, CustomType: jsontypes.NormalizedType{}, Description: descriptionLocationAnalysisGeneratedEntity},
+						"fields": schema.ListNestedAttribute{Computed: true, Description: descriptionLocationAnalysisGeneratedFields,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field":       schema.StringAttribute{Computed: true, MarkdownDescription: descriptionLocationAnalysisFieldField},
+									"state":       schema.StringAttribute{Computed: true, MarkdownDescription: descriptionLocationAnalysisFieldState},
+									"value":       schema.StringAttribute{Computed: true, Description: descriptionLocationAnalysisFieldValue},
+									"description": schema.StringAttribute{Computed: true, Description: descriptionLocationAnalysisFieldDesc},
+								},
+							}},
+					},
+				}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *locationAnalysisDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *locationAnalysisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state locationAnalysisDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locationType := defaultLocationType
+	if !state.Type.IsNull() {
+		locationType = state.Type.ValueString()
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Analyzing location %s:%s with Backstage API", locationType, state.Target.ValueString()))
+	analysis, response, err := d.client.AnalyzeLocation(ctx, &client.AnalyzeLocationOptions{
+		Location:        client.LocationSpec{Type: locationType, Target: state.Target.ValueString()},
+		CatalogFilename: state.CatalogFilename.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error analyzing Backstage location",
+			fmt.Sprintf("Could not analyze Backstage location %s:%s: %s", locationType, state.Target.ValueString(), err.Error()))
+		return
+	}
+
+	if response.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Error analyzing Backstage location",
+			fmt.Sprintf("Could not analyze Backstage location %s:%s: %s", locationType, state.Target.ValueString(), response.Status))
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", locationType, state.Target.ValueString()))
+	state.ExistingEntities = []locationAnalysisExistingEntityModel{}
+	for _, e := range analysis.ExistingEntityFiles {
+		var entity backstage.Entity
+		if err := json.Unmarshal(e.Entity, &entity); err != nil {
+			resp.Diagnostics.AddError("Error parsing Backstage location analysis",
+				fmt.Sprintf("Could not parse entity of Backstage location %s: %s", state.ID.ValueString(), err.Error()))
+			return
+		}
+
+		state.ExistingEntities = append(state.ExistingEntities, locationAnalysisExistingEntityModel{
+			Ref:            types.StringValue(newAnalyzedEntityRef(&entity)),
+			Entity:         jsontypes.NewNormalizedValue(string(e.Entity)),
+			LocationType:   types.StringValue(e.Location.Type),
+			LocationTarget: types.StringValue(e.Location.Target),
+			IsRegistered:   types.BoolValue(e.IsRegistered),
+		})
+	}
+
+	state.GeneratedEntities = []locationAnalysisGeneratedEntityModel{}
+	for _, e := range analysis.GenerateEntities {
+		generated := locationAnalysisGeneratedEntityModel{
+			Entity: jsontypes.NewNormalizedValue(string(e.Entity)),
+			Fields: []locationAnalysisGeneratedFieldModel{},
+		}
+
+		for _, f := range e.Fields {
+			generated.Fields = append(generated.Fields, locationAnalysisGeneratedFieldModel{
+				Field:       types.StringValue(f.Field),
+				State:       types.StringValue(f.State),
+				Value:       types.StringPointerValue(f.Value),
+				Description: types.StringValue(f.Description),
+			})
+		}
+
+		state.GeneratedEntities = append(state.GeneratedEntities, generated)
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newAnalyzedEntityRef returns the reference of an entity read from a catalog file, which may not have the namespace set.
+func newAnalyzedEntityRef(e *backstage.Entity) string {
+	namespace := e.Metadata.Namespace
+	if namespace == "" {
+		namespace = backstage.DefaultNamespaceName
+	}
+
+	return client.EntityRef{Kind: e.Kind, Namespace: namespace, Name: e.Metadata.Name}.String()
+}
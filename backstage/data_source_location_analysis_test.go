@@ -0,0 +1,31 @@
+package backstage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceLocationAnalysis(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceLocationAnalysisConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_location_analysis.test", "id",
+						"url:https://github.com/backstage/backstage/blob/master/packages/catalog-model/examples/components/artist-lookup-component.yaml"),
+					resource.TestCheckResourceAttr("data.backstage_location_analysis.test", "existing_entities.0.ref", "component:default/artist-lookup"),
+					resource.TestCheckResourceAttrSet("data.backstage_location_analysis.test", "existing_entities.0.entity"),
+					resource.TestCheckResourceAttr("data.backstage_location_analysis.test", "generated_entities.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceLocationAnalysisConfig = `
+data "backstage_location_analysis" "test" {
+  target = "https://github.com/backstage/backstage/blob/master/packages/catalog-model/examples/components/artist-lookup-component.yaml"
+}
+`
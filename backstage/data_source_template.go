@@ -0,0 +1,367 @@
+package backstage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &templateDataSource{}
+	_ datasource.DataSourceWithConfigure = &templateDataSource{}
+)
+
+// NewTemplateDataSource is a helper function to simplify the provider implementation.
+func NewTemplateDataSource() datasource.DataSource {
+	return &templateDataSource{}
+}
+
+// templateDataSource is the data source implementation.
+type templateDataSource struct {
+	client *client.Client
+}
+
+type templateDataSourceModel struct {
+	ID                     types.String            `tfsdk:"id"`
+	Name                   types.String            `tfsdk:"name"`
+	Namespace              types.String            `tfsdk:"namespace"`
+	ApiVersion             types.String            `tfsdk:"api_version"`
+	Kind                   types.String            `tfsdk:"kind"`
+	Metadata               *entityMetadataModel    `tfsdk:"metadata"`
+	Relations              []entityRelationModel   `tfsdk:"relations"`
+	Status                 []entityStatusItemModel `tfsdk:"status"`
+	Spec                   *templateSpecModel      `tfsdk:"spec"`
+	FailOnProcessingErrors types.Bool              `tfsdk:"fail_on_processing_errors"`
+	Fallback               *templateFallbackModel  `tfsdk:"fallback"`
+}
+
+type templateSpecModel struct {
+	Type       types.String         `tfsdk:"type"`
+	Owner      types.String         `tfsdk:"owner"`
+	Parameters jsontypes.Normalized `tfsdk:"parameters"`
+	Steps      []templateStepModel  `tfsdk:"steps"`
+	Output     jsontypes.Normalized `tfsdk:"output"`
+}
+
+type templateStepModel struct {
+	ID     types.String         `tfsdk:"id"`
+	Name   types.String         `tfsdk:"name"`
+	Action types.String         `tfsdk:"action"`
+	Input  jsontypes.Normalized `tfsdk:"input"`
+}
+
+type templateFallbackModel struct {
+	ID         types.String          `tfsdk:"id"`
+	Name       types.String          `tfsdk:"name"`
+	Namespace  types.String          `tfsdk:"namespace"`
+	ApiVersion types.String          `tfsdk:"api_version"`
+	Kind       types.String          `tfsdk:"kind"`
+	Metadata   *entityMetadataModel  `tfsdk:"metadata"`
+	Relations  []entityRelationModel `tfsdk:"relations"`
+	Spec       *templateSpecModel    `tfsdk:"spec"`
+}
+
+const (
+	descriptionTemplateSpecType       = "Type of the component created by the template, e.g. `website`."
+	descriptionTemplateSpecOwner      = "An entity reference to the owner of the template."
+	descriptionTemplateSpecParameters = "A JSONSchema, or a list of JSONSchemas (one per form step), describing the inputs of the template (as JSON)."
+	descriptionTemplateSpecSteps      = "A list of steps executed by the template."
+	descriptionTemplateStepID         = "Identifier of the step."
+	descriptionTemplateStepName       = "Name of the step."
+	descriptionTemplateStepAction     = "Action executed by the step, e.g. `fetch:template`."
+	descriptionTemplateStepInput      = "Input of the action (as JSON)."
+	descriptionTemplateSpecOutput     = "A description of the outputs of the template, e.g. links and text (as JSON)."
+	descriptionTemplateFallback       = "A complete replica of the `Template` as it would exist in backstage. Set this to provide a fallback in case the Backstage instance is not functioning, is down, or is unrealiable."
+)
+
+// Metadata returns the data source type name.
+func (d *templateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *templateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get a specific " +
+			"[Template entity](https://backstage.io/docs/features/software-catalog/descriptor-format#kind-template) from Backstage Software Catalog.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
+			"name": schema.StringAttribute{Required: true, Description: descriptionEntityMetadataName, Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(patternEntityName),
+					"must follow Backstage format restrictions",
+				),
+			}},
+			"namespace": schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataNamespace, Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(patternEntityName),
+					"must follow Backstage format restrictions",
+				),
+			}},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionEntityFailOnProcessingErrors},
+			"api_version":               schema.StringAttribute{Computed: true, Description: descriptionEntityApiVersion},
+			"kind":                      schema.StringAttribute{Computed: true, Description: descriptionEntityKind},
+			"metadata": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
+				"uid":         schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataUID},
+				"etag":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataEtag},
+				"name":        schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataName},
+				"namespace":   schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataNamespace},
+				"title":       schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataTitle},
+				"description": schema.StringAttribute{Computed: true, Description: descriptionEntityMetadataDescription},
+				"labels":      schema.MapAttribute{Computed: true, Description: descriptionEntityMetadataLabels, ElementType: types.StringType},
+				"annotations": schema.MapAttribute{Computed: true, Description: descriptionEntityMetadataAnnotations, ElementType: types.StringType},
+				"tags":        schema.ListAttribute{Computed: true, Description: descriptionEntityMetadataTags, ElementType: types.StringType},
+				"links": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityMetadataLinks, NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url":   schema.StringAttribute{Computed: true, Description: descriptionEntityLinkURL},
+						"title": schema.StringAttribute{Computed: true, Description: descriptionEntityLinkTitle},
+						"icon":  schema.StringAttribute{Computed: true, Description: descriptionEntityLinkIco},
+						"type":  schema.StringAttribute{Computed: true, Description: descriptionEntityLinkType},
+					},
+				}},
+			}},
+			"relations": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityRelations, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":       schema.StringAttribute{Computed: true, Description: descriptionEntityRelationType},
+					"target_ref": schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetRef},
+					"target": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityRelationTarget,
+						Attributes: map[string]schema.Attribute{
+							"name":      schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetName},
+							"kind":      schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetKind},
+							"namespace": schema.StringAttribute{Computed: true, Description: descriptionEntityRelationTargetNamespace},
+						}},
+				},
+			}},
+			"status": schema.ListNestedAttribute{Computed: true, Description: descriptionEntityStatus, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type":    schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusType},
+					"level":   schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityStatusLevel},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusMessage},
+					"error": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntityStatusError, Attributes: map[string]schema.Attribute{
+						"name":    schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorName},
+						"message": schema.StringAttribute{Computed: true, Description: descriptionEntityStatusErrorMessage},
+					}},
+				},
+			}},
+			"spec": schema.SingleNestedAttribute{Computed: true, Description: descriptionEntitySpec, Attributes: map[string]schema.Attribute{
+				"type":       schema.StringAttribute{Computed: true, MarkdownDescription: descriptionTemplateSpecType},
+				"owner":      schema.StringAttribute{Computed: true, Description: descriptionTemplateSpecOwner},
+				"parameters": schema.StringAttribute{Computed: true, Description: descriptionTemplateSpecParameters, CustomType: jsontypes.NormalizedType{}},
+				"steps": schema.ListNestedAttribute{Computed: true, Description: descriptionTemplateSpecSteps, NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":     schema.StringAttribute{Computed: true, Description: descriptionTemplateStepID},
+						"name":   schema.StringAttribute{Computed: true, Description: descriptionTemplateStepName},
+						"action": schema.StringAttribute{Computed: true, MarkdownDescription: descriptionTemplateStepAction},
+						"input":  schema.StringAttribute{Computed: true, Description: descriptionTemplateStepInput, CustomType: jsontypes.NormalizedType{}},
+					},
+				}},
+				"output": schema.StringAttribute{Computed: true, Description: descriptionTemplateSpecOutput, CustomType: jsontypes.NormalizedType{}},
+			}},
+			"fallback": schema.SingleNestedAttribute{Optional: true, Description: descriptionTemplateFallback, Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataUID},
+				"name": schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataName, Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(patternEntityName),
+						"must follow Backstage format restrictions",
+					),
+				}},
+				"namespace": schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataNamespace, Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(patternEntityName),
+						"must follow Backstage format restrictions",
+					),
+				}},
+				"api_version": schema.StringAttribute{Optional: true, Description: descriptionEntityApiVersion},
+				"kind":        schema.StringAttribute{Optional: true, Description: descriptionEntityKind},
+				"metadata": schema.SingleNestedAttribute{Optional: true, Description: descriptionEntityMetadata, Attributes: map[string]schema.Attribute{
+					"uid":         schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataUID},
+					"etag":        schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataEtag},
+					"name":        schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataName},
+					"namespace":   schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataNamespace},
+					"title":       schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataTitle},
+					"description": schema.StringAttribute{Optional: true, Description: descriptionEntityMetadataDescription},
+					"labels":      schema.MapAttribute{Optional: true, Description: descriptionEntityMetadataLabels, ElementType: types.StringType},
+					"annotations": schema.MapAttribute{Optional: true, Description: descriptionEntityMetadataAnnotations, ElementType: types.StringType},
+					"tags":        schema.ListAttribute{Optional: true, Description: descriptionEntityMetadataTags, ElementType: types.StringType},
+					"links": schema.ListNestedAttribute{Optional: true, Description: descriptionEntityMetadataLinks, NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"url":   schema.StringAttribute{Optional: true, Description: descriptionEntityLinkURL},
+							"title": schema.StringAttribute{Optional: true, Description: descriptionEntityLinkTitle},
+							"icon":  schema.StringAttribute{Optional: true, Description: descriptionEntityLinkIco},
+							"type":  schema.StringAttribute{Optional: true, Description: descriptionEntityLinkType},
+						},
+					}},
+				}},
+				"relations": schema.ListNestedAttribute{Optional: true, Description: descriptionEntityRelations, NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type":       schema.StringAttribute{Optional: true, Description: descriptionEntityRelationType},
+						"target_ref": schema.StringAttribute{Optional: true, Description: descriptionEntityRelationTargetRef},
+						"target": schema.SingleNestedAttribute{Optional: true, Description: descriptionEntityRelationTarget,
+							Attributes: map[string]schema.Attribute{
+								"name":      schema.StringAttribute{Optional: true, Description: descriptionEntityRelationTargetName},
+								"kind":      schema.StringAttribute{Optional: true, Description: descriptionEntityRelationTargetKind},
+								"namespace": schema.StringAttribute{Optional: true, Description: descriptionEntityRelationTargetNamespace},
+							}},
+					},
+				}},
+				"spec": schema.SingleNestedAttribute{Optional: true, Description: descriptionEntitySpec, Attributes: map[string]schema.Attribute{
+					"type":       schema.StringAttribute{Optional: true, MarkdownDescription: descriptionTemplateSpecType},
+					"owner":      schema.StringAttribute{Optional: true, Description: descriptionTemplateSpecOwner},
+					"parameters": schema.StringAttribute{Optional: true, Description: descriptionTemplateSpecParameters, CustomType: jsontypes.NormalizedType{}},
+					"steps": schema.ListNestedAttribute{Optional: true, Description: descriptionTemplateSpecSteps, NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id":     schema.StringAttribute{Optional: true, Description: descriptionTemplateStepID},
+							"name":   schema.StringAttribute{Optional: true, Description: descriptionTemplateStepName},
+							"action": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionTemplateStepAction},
+							"input":  schema.StringAttribute{Optional: true, Description: descriptionTemplateStepInput, CustomType: jsontypes.NormalizedType{}},
+						},
+					}},
+					"output": schema.StringAttribute{Optional: true, Description: descriptionTemplateSpecOutput, CustomType: jsontypes.NormalizedType{}},
+				}},
+			}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *templateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *templateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state templateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Namespace.IsNull() {
+		state.Namespace = types.StringValue(d.client.DefaultNamespace)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Getting Template kind %s/%s from Backstage API", state.Name.ValueString(), state.Namespace.ValueString()))
+	template, response, err := d.client.GetTemplate(ctx, state.Name.ValueString(), state.Namespace.ValueString())
+	if err != nil {
+		const shortErr = "Error reading Backstage Template kind"
+		longErr := fmt.Sprintf("Could not read Backstage Template kind %s/%s: %s", state.Namespace.ValueString(), state.Name.ValueString(), err.Error())
+		if state.Fallback == nil {
+			resp.Diagnostics.AddError(shortErr, longErr)
+			return
+		}
+		resp.Diagnostics.AddWarning(shortErr, longErr)
+	}
+
+	if err == nil && response.StatusCode != http.StatusOK {
+		const shortErr = "Error reading Backstage Template kind"
+		longErr := fmt.Sprintf("Could not read Backstage Template kind %s/%s: %s", state.Namespace.ValueString(), state.Name.ValueString(), response.Status)
+		if state.Fallback == nil {
+			resp.Diagnostics.AddError(shortErr, longErr)
+			return
+		}
+		resp.Diagnostics.AddWarning(shortErr, longErr)
+	}
+	if (err != nil || response.StatusCode != http.StatusOK) && state.Fallback != nil {
+		if state.Fallback.ID.IsNull() {
+			state.Fallback.ID = types.StringValue("123456789")
+		}
+		if state.Fallback.ApiVersion.IsNull() {
+			state.Fallback.ApiVersion = types.StringValue("scaffolder.backstage.io/v1beta3")
+		}
+		if state.Fallback.Kind.IsNull() {
+			state.Fallback.Kind = types.StringValue(client.KindTemplate)
+		}
+		state.ID = state.Fallback.ID
+		state.Name = state.Fallback.Name
+		state.Namespace = state.Fallback.Namespace
+		state.ApiVersion = state.Fallback.ApiVersion
+		state.Kind = state.Fallback.Kind
+		state.Metadata = state.Fallback.Metadata
+		state.Relations = state.Fallback.Relations
+		state.Spec = state.Fallback.Spec
+	}
+
+	if err == nil && response.StatusCode == http.StatusOK {
+		addStaleResponseWarning(&resp.Diagnostics, response, fmt.Sprintf("Template kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+
+		state.Status = newEntityStatusItemModels(template.Status)
+		if state.FailOnProcessingErrors.ValueBool() {
+			addEntityStatusErrors(&resp.Diagnostics, template.Status, fmt.Sprintf("Template kind %s/%s", state.Namespace.ValueString(), state.Name.ValueString()))
+		}
+
+		state.ID = types.StringValue(template.Metadata.UID)
+		state.ApiVersion = types.StringValue(template.ApiVersion)
+		state.Kind = types.StringValue(template.Kind)
+
+		state.Metadata = newEntityMetadataModel(&template.Metadata)
+		state.Relations = newEntityRelationModels(template.Relations)
+
+		if template.Spec == nil {
+			template.Spec = &client.TemplateEntityV1beta3Spec{}
+		}
+
+		state.Spec = &templateSpecModel{
+			Type:       types.StringValue(template.Spec.Type),
+			Owner:      types.StringValue(template.Spec.Owner),
+			Parameters: jsontypes.NewNormalizedNull(),
+			Steps:      []templateStepModel{},
+			Output:     jsontypes.NewNormalizedNull(),
+		}
+
+		if len(template.Spec.Parameters) > 0 {
+			state.Spec.Parameters = jsontypes.NewNormalizedValue(string(template.Spec.Parameters))
+		}
+
+		if len(template.Spec.Output) > 0 {
+			output, err := json.Marshal(template.Spec.Output)
+			if err != nil {
+				resp.Diagnostics.AddError("Error parsing Backstage Template kind output", fmt.Sprintf("Could not parse output of Backstage "+
+					"Template kind %s/%s: %s", state.Namespace.ValueString(), state.Name.ValueString(), err.Error()))
+				return
+			}
+			state.Spec.Output = jsontypes.NewNormalizedValue(string(output))
+		}
+
+		for _, i := range template.Spec.Steps {
+			input, err := json.Marshal(i.Input)
+			if err != nil {
+				resp.Diagnostics.AddError("Error parsing Backstage Template kind steps", fmt.Sprintf("Could not parse input of step %s of Backstage "+
+					"Template kind %s/%s: %s", i.ID, state.Namespace.ValueString(), state.Name.ValueString(), err.Error()))
+				return
+			}
+
+			state.Spec.Steps = append(state.Spec.Steps, templateStepModel{
+				ID:     types.StringValue(i.ID),
+				Name:   types.StringValue(i.Name),
+				Action: types.StringValue(i.Action),
+				Input:  jsontypes.NewNormalizedValue(string(input)),
+			})
+		}
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,111 @@
+package backstage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + testAccDataSourceTemplateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_template.test", "api_version", "scaffolder.backstage.io/v1beta3"),
+					resource.TestCheckResourceAttr("data.backstage_template.test", "kind", "Template"),
+					resource.TestCheckResourceAttr("data.backstage_template.test", "metadata.name", "react-ssr-template"),
+					resource.TestCheckResourceAttr("data.backstage_template.test", "spec.type", "website"),
+					resource.TestCheckResourceAttrSet("data.backstage_template.test", "spec.parameters"),
+					resource.TestCheckResourceAttrSet("data.backstage_template.test", "spec.steps.0.action"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceTemplateConfig = `
+data "backstage_template" "test" {
+  name = "react-ssr-template"
+}
+`
+
+func TestAccDataSourceTemplate_WithFallback(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "backstage_template" "test" {
+						name = "non_existent_template_5c1e2"
+						namespace = "default"
+						fallback = {
+							id = "123456"
+							name = "fallback_template"
+							namespace = "default"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.backstage_template.test", "kind", "Template"),
+					resource.TestCheckResourceAttr("data.backstage_template.test", "name", "fallback_template"),
+					resource.TestCheckResourceAttr("data.backstage_template.test", "api_version", "scaffolder.backstage.io/v1beta3"),
+					resource.TestCheckNoResourceAttr("data.backstage_template.test", "metadata"),
+				),
+			},
+		},
+	})
+}
+
+func TestTemplateDataSource_FallbackOnTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	d := &templateDataSource{client: c}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}
+	assert.Emptyf(t, config.Set(context.Background(), &templateDataSourceModel{Name: types.StringValue("test"),
+		Fallback: &templateFallbackModel{Name: types.StringValue("test"), Namespace: types.StringValue("default")}}), "Config should be set")
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	assert.NotPanicsf(t, func() {
+		d.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &readResp)
+	}, "Read should not panic on transport errors")
+	assert.Falsef(t, readResp.Diagnostics.HasError(), "Read should not return errors, when fallback is set")
+	assert.Equalf(t, 1, readResp.Diagnostics.WarningsCount(), "Transport error should be reported as a warning")
+
+	var state templateDataSourceModel
+	readResp.State.Get(context.Background(), &state)
+	assert.Equalf(t, "123456789", state.ID.ValueString(), "Fallback should be used")
+}
+
+func TestTemplateDataSource_ProviderDefaultNamespace(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	c.DefaultNamespace = "team-a"
+
+	readDataSource(t, &templateDataSource{client: c}, &templateDataSourceModel{Name: types.StringValue("react-app")})
+	assert.Equalf(t, "/api/catalog/entities/by-name/template/team-a/react-app", path,
+		"Namespace should default to the one configured in the provider")
+}
@@ -2,18 +2,25 @@ package backstage
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
 	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
-	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
 var _ provider.Provider = &backstageProvider{}
@@ -32,30 +40,159 @@ type backstageProvider struct {
 
 // backstageProviderModel describes the provider data model.
 type backstageProviderModel struct {
-	BaseURL          types.String `tfsdk:"base_url"`
-	DefaultNamespace types.String `tfsdk:"default_namespace"`
-	Headers          types.Map    `tfsdk:"headers"`
-	Retries          types.Int64  `tfsdk:"retries"`
-	TimeoutSeconds   types.Int64  `tfsdk:"timeout_seconds"`
+	BaseURL               types.String                  `tfsdk:"base_url"`
+	DefaultNamespace      types.String                  `tfsdk:"default_namespace"`
+	Headers               types.Map                     `tfsdk:"headers"`
+	SensitiveHeaders      types.String                  `tfsdk:"sensitive_headers_pattern"`
+	Retries               types.Int64                   `tfsdk:"retries"`
+	TimeoutSeconds        types.Int64                   `tfsdk:"timeout_seconds"`
+	Auth                  *backstageProviderAuthModel   `tfsdk:"auth"`
+	OAuth2                *backstageProviderOAuth2Model `tfsdk:"oauth2"`
+	TLS                   *backstageProviderTLSModel    `tfsdk:"tls"`
+	Retry                 *backstageProviderRetryModel  `tfsdk:"retry"`
+	MaxRequestsPerSecond  types.Float64                 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64                   `tfsdk:"max_concurrent_requests"`
+	CacheTTL              types.String                  `tfsdk:"cache_ttl"`
+	CacheDir              types.String                  `tfsdk:"cache_dir"`
+	MaxStale              types.String                  `tfsdk:"max_stale"`
+}
+
+// backstageProviderAuthModel describes the provider authentication data model.
+type backstageProviderAuthModel struct {
+	LegacySecret    types.String `tfsdk:"legacy_secret"`
+	LegacySubject   types.String `tfsdk:"legacy_subject"`
+	StaticToken     types.String `tfsdk:"static_token"`
+	TokenTTLSeconds types.Int64  `tfsdk:"token_ttl_seconds"`
+}
+
+// backstageProviderOAuth2Model describes the provider OAuth2 client credentials data model.
+type backstageProviderOAuth2Model struct {
+	TokenURL     types.String `tfsdk:"token_url"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	Audience     types.String `tfsdk:"audience"`
+}
+
+// backstageProviderTLSModel describes the provider TLS data model.
+type backstageProviderTLSModel struct {
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ServerName         types.String `tfsdk:"server_name"`
+}
+
+// backstageProviderRetryModel describes the provider retry policy data model.
+type backstageProviderRetryModel struct {
+	MaxAttempts       types.Int64  `tfsdk:"max_attempts"`
+	MinWait           types.String `tfsdk:"min_wait"`
+	MaxWait           types.String `tfsdk:"max_wait"`
+	RetryOnStatus     types.List   `tfsdk:"retry_on_status"`
+	RespectRetryAfter types.Bool   `tfsdk:"respect_retry_after"`
 }
 
 const (
 	patternURL                 = "https?://.+"
+	patternDuration            = `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
 	envBaseURL                 = "BACKSTAGE_BASE_URL"
 	envDefaultNamespace        = "BACKSTAGE_DEFAULT_NAMESPACE"
 	envHeaders                 = "BACKSTAGE_HEADERS"
+	envSensitiveHeaders        = "BACKSTAGE_SENSITIVE_HEADERS_PATTERN"
 	envRetries                 = "BACKSTAGE_RETRIES"
 	envTimeoutSeconds          = "BACKSTAGE_TIMEOUT_SECONDS"
+	envMaxRequestsPerSecond    = "BACKSTAGE_MAX_REQUESTS_PER_SECOND"
+	envMaxConcurrentRequests   = "BACKSTAGE_MAX_CONCURRENT_REQUESTS"
+	envCacheTTL                = "BACKSTAGE_CACHE_TTL"
+	envCacheDir                = "BACKSTAGE_CACHE_DIR"
+	envMaxStale                = "BACKSTAGE_MAX_STALE"
+	envAuthLegacySecret        = "BACKSTAGE_AUTH_LEGACY_SECRET"
+	envAuthLegacySubject       = "BACKSTAGE_AUTH_LEGACY_SUBJECT"
+	envAuthStaticToken         = "BACKSTAGE_AUTH_STATIC_TOKEN"
+	envOAuth2TokenURL          = "BACKSTAGE_OAUTH2_TOKEN_URL"
+	envOAuth2ClientID          = "BACKSTAGE_OAUTH2_CLIENT_ID"
+	envOAuth2ClientSecret      = "BACKSTAGE_OAUTH2_CLIENT_SECRET"
+	envOAuth2Scopes            = "BACKSTAGE_OAUTH2_SCOPES"
+	envOAuth2Audience          = "BACKSTAGE_OAUTH2_AUDIENCE"
+	envCACert                  = "BACKSTAGE_CA_CERT"
+	envCACertFile              = "BACKSTAGE_CA_CERT_FILE"
+	envClientCert              = "BACKSTAGE_CLIENT_CERT"
+	envClientKey               = "BACKSTAGE_CLIENT_KEY"
+	envTLSInsecureSkipVerify   = "BACKSTAGE_TLS_INSECURE_SKIP_VERIFY"
+	envTLSServerName           = "BACKSTAGE_TLS_SERVER_NAME"
 	descriptionProviderBaseURL = "Base URL of the Backstage instance, e.g. https://demo.backstage.io. May also be provided via `" + envBaseURL +
 		"` environment variable."
 	descriptionProviderDefaultNamespace = "Name of default namespace for entities (`default`, if not set). May also be provided via `" + envDefaultNamespace +
 		"` environment variable."
 	descriptionProviderHeaders = "Headers to be sent with each request to the Backstage API. Useful for authentication. May also be provided via `" + envHeaders +
-		"` environment variable."
+		"` environment variable, either as a JSON object (e.g. `{\"Custom-Header\":\"value\"}`) or as comma separated `key=value` pairs, with values " +
+		"containing commas enclosed in double quotes (e.g. `Custom-Header=value,Cookie=\"a=1,b=2\"`). Headers from the environment variable are merged " +
+		"with the configured ones, which take precedence."
+	descriptionProviderSensitiveHeaders = "Regular expression matching names of headers, which values must be masked in the provider logs (default: `" +
+		transport.DefaultSensitiveHeadersPattern + "`). `Authorization`, `Proxy-Authorization`, `Cookie` and `Set-Cookie` headers are always masked. " +
+		"May also be provided via `" + envSensitiveHeaders + "` environment variable."
 	descriptionProviderRetries = "Number of retries to attempt on recoverable API errors (default: 0). May also be provided via `" + envRetries +
+		"` environment variable. Use `retry` for finer control of the retry policy."
+	descriptionProviderMaxRequestsPerSecond = "Maximal number of requests per second sent to the Backstage API by all data sources and resources (unlimited, " +
+		"if not set). May also be provided via `" + envMaxRequestsPerSecond + "` environment variable."
+	descriptionProviderMaxConcurrentRequests = "Maximal number of requests to the Backstage API in flight at the same time, shared by all data sources " +
+		"and resources (unlimited, if not set). May also be provided via `" + envMaxConcurrentRequests + "` environment variable."
+	descriptionProviderCacheTTL = "Time to cache successful responses of the Backstage API for, e.g. `30s` or `5m` (disabled, if not set). " +
+		"Cached responses are shared by all data sources and resources during a single Terraform run, and identical requests in flight share " +
+		"a single call. Any change made through the API (e.g. creation of a location) clears the cache. May also be provided via `" + envCacheTTL +
 		"` environment variable."
+	descriptionProviderCacheDir = "Directory to persist the last successful response of each lookup in (disabled, if not set). When the Backstage " +
+		"API cannot be reached, responds with a server error or rate limits the requests, data sources use the persisted response instead and " +
+		"report its age in a warning. Consider the directory contents as sensitive as the catalog itself. May also be provided via `" +
+		envCacheDir + "` environment variable."
+	descriptionProviderMaxStale = "Maximal age of a persisted response, e.g. `24h`, for it to be used instead of the Backstage API (unlimited, if not " +
+		"set). Older responses are ignored and the lookup fails as if there was no `cache_dir`. May also be provided via `" + envMaxStale +
+		"` environment variable."
+	descriptionProviderRetry            = "Policy of retrying requests failed due to recoverable errors. Each retry attempt is logged."
+	descriptionProviderRetryMaxAttempts = "Maximal number of attempts to send a request, including the first one. Takes precedence over `retries`, " +
+		"if both are set."
+	descriptionProviderRetryMinWait = "Minimal time to wait before retrying a request, e.g. `500ms` (default: `1s`). The wait time grows exponentially " +
+		"with each attempt."
+	descriptionProviderRetryMaxWait       = "Maximal time to wait before retrying a request, e.g. `1m` (default: `30s`)."
+	descriptionProviderRetryRetryOnStatus = "Response status codes to retry on (default: `429` and `5xx`, except `501`). Requests failed due to " +
+		"recoverable connection errors are always retried."
+	descriptionProviderRetryRespectRetryAfter = "Whether to wait for the time requested by the `Retry-After` header of `429` and `503` responses, " +
+		"instead of the computed backoff (default: `true`)."
 	descriptionProviderTimeoutSeconds = "Timeout for requests to the Backstage API in seconds (default: 15). May also be provided via `" + envTimeoutSeconds +
 		"` environment variable."
+	descriptionProviderAuth = "Authentication of the provider against the Backstage backend. The minted or configured token is sent in the `Authorization` header " +
+		"and takes precedence over the one set via `headers`."
+	descriptionProviderAuthLegacySecret = "Base64 encoded shared secret, as configured in `backend.auth.keys` or in `backend.auth.externalAccess` (type `legacy`) " +
+		"of the Backstage instance, used to mint short-lived backend tokens. The tokens are refreshed automatically before they expire. May also be provided via `" +
+		envAuthLegacySecret + "` environment variable."
+	descriptionProviderAuthLegacySubject = "Subject (`sub` claim) of the minted backend tokens (default: `backstage-server`). Use the `subject` configured for the " +
+		"`legacy` external access method, if any. May also be provided via `" + envAuthLegacySubject + "` environment variable."
+	descriptionProviderAuthStaticToken = "Static token, as configured in `backend.auth.externalAccess` (type `static`) of the Backstage instance. May also be " +
+		"provided via `" + envAuthStaticToken + "` environment variable."
+	descriptionProviderAuthTokenTTLSeconds = "Lifetime of the minted backend tokens in seconds (default: 3600)."
+	descriptionProviderOAuth2              = "OAuth2 client credentials used to obtain bearer tokens, e.g. when Backstage instance is behind an identity-aware proxy. " +
+		"Tokens are cached and requested again before they expire. Cannot be combined with `auth`."
+	descriptionProviderOAuth2TokenURL = "URL of the token endpoint of the authorization server. May also be provided via `" + envOAuth2TokenURL +
+		"` environment variable."
+	descriptionProviderOAuth2ClientID     = "Client ID of the application. May also be provided via `" + envOAuth2ClientID + "` environment variable."
+	descriptionProviderOAuth2ClientSecret = "Client secret of the application. May also be provided via `" + envOAuth2ClientSecret +
+		"` environment variable."
+	descriptionProviderOAuth2Scopes   = "Scopes to request. May also be provided via `" + envOAuth2Scopes + "` environment variable (comma separated)."
+	descriptionProviderOAuth2Audience = "Audience to request the token for (sent as `audience` parameter). May also be provided via `" + envOAuth2Audience +
+		"` environment variable."
+	descriptionProviderTLS          = "TLS settings used when connecting to the Backstage instance (and the OAuth2 token endpoint)."
+	descriptionProviderTLSCACertPEM = "PEM encoded CA certificates to trust in addition to the system ones. May also be provided via `" + envCACert +
+		"` environment variable."
+	descriptionProviderTLSCACertFile = "Path to a file with PEM encoded CA certificates to trust in addition to the system ones. May also be provided via `" +
+		envCACertFile + "` environment variable."
+	descriptionProviderTLSClientCert = "PEM encoded client certificate to present for mutual TLS authentication. May also be provided via `" + envClientCert +
+		"` environment variable."
+	descriptionProviderTLSClientKey          = "PEM encoded private key of the client certificate. May also be provided via `" + envClientKey + "` environment variable."
+	descriptionProviderTLSInsecureSkipVerify = "Disables verification of the server certificate chain and host name. **Use only for testing**, as it makes the " +
+		"connection vulnerable to man-in-the-middle attacks. May also be provided via `" + envTLSInsecureSkipVerify + "` environment variable."
+	descriptionProviderTLSServerName = "Server name used to verify the certificate of the Backstage instance, if it differs from the host of the base URL. " +
+		"May also be provided via `" + envTLSServerName + "` environment variable."
 )
 
 // Metadata returns the provider type name.
@@ -81,9 +218,78 @@ func (p *backstageProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				stringvalidator.LengthBetween(1, 63),
 				stringvalidator.RegexMatches(regexp.MustCompile(patternEntityName), "must follow Backstage format restrictions"),
 			}},
-			"headers":         schema.MapAttribute{Optional: true, ElementType: types.StringType, MarkdownDescription: descriptionProviderHeaders},
+			"headers": schema.MapAttribute{Optional: true, Sensitive: true, ElementType: types.StringType, MarkdownDescription: descriptionProviderHeaders},
+			"sensitive_headers_pattern": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderSensitiveHeaders, Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			}},
 			"retries":         schema.Int64Attribute{Optional: true, MarkdownDescription: descriptionProviderRetries},
 			"timeout_seconds": schema.Int64Attribute{Optional: true, MarkdownDescription: descriptionProviderTimeoutSeconds},
+			"max_requests_per_second": schema.Float64Attribute{Optional: true, MarkdownDescription: descriptionProviderMaxRequestsPerSecond,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				}},
+			"max_concurrent_requests": schema.Int64Attribute{Optional: true, MarkdownDescription: descriptionProviderMaxConcurrentRequests,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				}},
+			"cache_ttl": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderCacheTTL, Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(patternDuration), "must be a valid duration, e.g. 500ms, 10s or 1m"),
+			}},
+			"cache_dir": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderCacheDir, Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			}},
+			"max_stale": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderMaxStale, Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(patternDuration), "must be a valid duration, e.g. 500ms, 10s or 1m"),
+			}},
+			"retry": schema.SingleNestedAttribute{Optional: true, MarkdownDescription: descriptionProviderRetry, Attributes: map[string]schema.Attribute{
+				"max_attempts": schema.Int64Attribute{Optional: true, MarkdownDescription: descriptionProviderRetryMaxAttempts, Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				}},
+				"min_wait": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderRetryMinWait, Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(patternDuration), "must be a valid duration, e.g. 500ms, 10s or 1m"),
+				}},
+				"max_wait": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderRetryMaxWait, Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(patternDuration), "must be a valid duration, e.g. 500ms, 10s or 1m"),
+				}},
+				"retry_on_status": schema.ListAttribute{Optional: true, ElementType: types.Int64Type, MarkdownDescription: descriptionProviderRetryRetryOnStatus,
+					Validators: []validator.List{
+						listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+					}},
+				"respect_retry_after": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionProviderRetryRespectRetryAfter},
+			}},
+			"auth": schema.SingleNestedAttribute{Optional: true, MarkdownDescription: descriptionProviderAuth, Attributes: map[string]schema.Attribute{
+				"legacy_secret": schema.StringAttribute{Optional: true, Sensitive: true, MarkdownDescription: descriptionProviderAuthLegacySecret, Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("static_token")),
+				}},
+				"legacy_subject": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderAuthLegacySubject},
+				"static_token":   schema.StringAttribute{Optional: true, Sensitive: true, MarkdownDescription: descriptionProviderAuthStaticToken},
+				"token_ttl_seconds": schema.Int64Attribute{Optional: true, MarkdownDescription: descriptionProviderAuthTokenTTLSeconds, Validators: []validator.Int64{
+					int64validator.AtLeast(120),
+				}},
+			}},
+			"oauth2": schema.SingleNestedAttribute{Optional: true, MarkdownDescription: descriptionProviderOAuth2, Attributes: map[string]schema.Attribute{
+				"token_url": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderOAuth2TokenURL, Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(patternURL), "must be a valid URL"),
+				}},
+				"client_id":     schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderOAuth2ClientID},
+				"client_secret": schema.StringAttribute{Optional: true, Sensitive: true, MarkdownDescription: descriptionProviderOAuth2ClientSecret},
+				"scopes":        schema.ListAttribute{Optional: true, ElementType: types.StringType, MarkdownDescription: descriptionProviderOAuth2Scopes},
+				"audience":      schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderOAuth2Audience},
+			}},
+			"tls": schema.SingleNestedAttribute{Optional: true, MarkdownDescription: descriptionProviderTLS, Attributes: map[string]schema.Attribute{
+				"ca_cert_pem": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderTLSCACertPEM, Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ca_cert_file")),
+				}},
+				"ca_cert_file": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderTLSCACertFile},
+				"client_cert": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderTLSClientCert, Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_key")),
+				}},
+				"client_key": schema.StringAttribute{Optional: true, Sensitive: true, MarkdownDescription: descriptionProviderTLSClientKey, Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_cert")),
+				}},
+				"insecure_skip_verify": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionProviderTLSInsecureSkipVerify},
+				"server_name":          schema.StringAttribute{Optional: true, MarkdownDescription: descriptionProviderTLSServerName},
+			}},
 		},
 	}
 }
@@ -141,15 +347,36 @@ func (p *backstageProvider) Configure(ctx context.Context, req provider.Configur
 
 	headers := make(map[string]string)
 	if headersEnv := os.Getenv(envHeaders); headersEnv != "" {
-		for _, kv := range regexp.MustCompile(`(.*?)=([^=]*)(?:,|$)`).FindAllStringSubmatch(headersEnv, -1) {
-			headers[kv[1]] = kv[2]
+		envHeadersMap, err := parseHeaders(headersEnv)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("headers"), "Invalid headers", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as there is invalid value for the headers in the %s environment variable: %s. "+
+					"Use either a JSON object or comma separated key=value pairs, quoting values that contain commas.", envHeaders, err.Error()))
+		} else {
+			headers = envHeadersMap
 		}
-	} else {
-		if !config.Headers.IsNull() {
-			config.Headers.ElementsAs(ctx, &headers, true)
+	}
+
+	if !config.Headers.IsNull() {
+		configHeaders := make(map[string]string)
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &configHeaders, true)...)
+		for k, v := range configHeaders {
+			headers[k] = v
 		}
 	}
 
+	sensitiveHeaders := os.Getenv(envSensitiveHeaders)
+	if !config.SensitiveHeaders.IsNull() {
+		sensitiveHeaders = config.SensitiveHeaders.ValueString()
+	}
+
+	masker, err := transport.NewHeaderMasker(sensitiveHeaders)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("sensitive_headers_pattern"), "Invalid pattern of sensitive headers", fmt.Sprintf(
+			"The provider cannot create the Backstage API client as there is invalid regular expression for sensitive headers: %s. Set the value in the "+
+				"configuration or use the %s environment variable.", err.Error(), envSensitiveHeaders))
+	}
+
 	retries := 0
 	retriesStr := os.Getenv(envRetries)
 	if retriesStr != "" {
@@ -163,6 +390,51 @@ func (p *backstageProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	retryPolicy := transport.RetryPolicy{
+		MaxAttempts:       retries + 1,
+		MinWait:           transport.DefaultRetryMinWait,
+		MaxWait:           transport.DefaultRetryMaxWait,
+		RespectRetryAfter: true,
+	}
+	if config.Retry != nil {
+		if !config.Retry.MaxAttempts.IsNull() {
+			retryPolicy.MaxAttempts = int(config.Retry.MaxAttempts.ValueInt64())
+		}
+
+		if !config.Retry.MinWait.IsNull() {
+			var err error
+			if retryPolicy.MinWait, err = time.ParseDuration(config.Retry.MinWait.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("retry").AtName("min_wait"), "Invalid minimal retry wait time", fmt.Sprintf(
+					"The provider cannot create the Backstage API client as there is invalid value for the minimal retry wait time: %s.", err.Error()))
+			}
+		}
+
+		if !config.Retry.MaxWait.IsNull() {
+			var err error
+			if retryPolicy.MaxWait, err = time.ParseDuration(config.Retry.MaxWait.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("retry").AtName("max_wait"), "Invalid maximal retry wait time", fmt.Sprintf(
+					"The provider cannot create the Backstage API client as there is invalid value for the maximal retry wait time: %s.", err.Error()))
+			}
+		}
+
+		if retryPolicy.MinWait > retryPolicy.MaxWait {
+			resp.Diagnostics.AddAttributeError(path.Root("retry"), "Invalid retry wait times",
+				"The provider cannot create the Backstage API client as the minimal retry wait time is longer than the maximal one.")
+		}
+
+		if !config.Retry.RetryOnStatus.IsNull() {
+			var statuses []int64
+			resp.Diagnostics.Append(config.Retry.RetryOnStatus.ElementsAs(ctx, &statuses, true)...)
+			for _, status := range statuses {
+				retryPolicy.RetryOnStatus = append(retryPolicy.RetryOnStatus, int(status))
+			}
+		}
+
+		if !config.Retry.RespectRetryAfter.IsNull() {
+			retryPolicy.RespectRetryAfter = config.Retry.RespectRetryAfter.ValueBool()
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -180,22 +452,281 @@ func (p *backstageProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	if config.TLS == nil {
+		config.TLS = &backstageProviderTLSModel{}
+	}
+
+	tlsConfig := transport.TLSConfig{
+		CACertPEM:     []byte(os.Getenv(envCACert)),
+		ClientCertPEM: []byte(os.Getenv(envClientCert)),
+		ClientKeyPEM:  []byte(os.Getenv(envClientKey)),
+		ServerName:    os.Getenv(envTLSServerName),
+	}
+	if !config.TLS.CACertPEM.IsNull() {
+		tlsConfig.CACertPEM = []byte(config.TLS.CACertPEM.ValueString())
+	}
+	if !config.TLS.ClientCert.IsNull() {
+		tlsConfig.ClientCertPEM = []byte(config.TLS.ClientCert.ValueString())
+	}
+	if !config.TLS.ClientKey.IsNull() {
+		tlsConfig.ClientKeyPEM = []byte(config.TLS.ClientKey.ValueString())
+	}
+	if !config.TLS.ServerName.IsNull() {
+		tlsConfig.ServerName = config.TLS.ServerName.ValueString()
+	}
+
+	caCertFile := os.Getenv(envCACertFile)
+	if !config.TLS.CACertFile.IsNull() {
+		caCertFile = config.TLS.CACertFile.ValueString()
+	}
+	if caCertFile != "" {
+		caCert, err := os.ReadFile(caCertFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("tls").AtName("ca_cert_file"), "Unable to read CA certificates file", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as the CA certificates file could not be read: %s. Set the path in the "+
+					"configuration or use the %s environment variable.", err.Error(), envCACertFile))
+		}
+		tlsConfig.CACertPEM = append(tlsConfig.CACertPEM, caCert...)
+	}
+
+	if insecureStr := os.Getenv(envTLSInsecureSkipVerify); insecureStr != "" {
+		var err error
+		if tlsConfig.InsecureSkipVerify, err = strconv.ParseBool(insecureStr); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("tls").AtName("insecure_skip_verify"), "Invalid TLS verification setting", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as there is invalid value for disabling TLS verification: %s.", envTLSInsecureSkipVerify))
+		}
+	}
+	if !config.TLS.InsecureSkipVerify.IsNull() {
+		tlsConfig.InsecureSkipVerify = config.TLS.InsecureSkipVerify.ValueBool()
+	}
+
+	if tlsConfig.InsecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(path.Root("tls").AtName("insecure_skip_verify"), "TLS certificate verification is disabled",
+			"The provider will not verify the certificate of the Backstage instance, which makes the connection vulnerable to man-in-the-middle attacks. "+
+				"Configure trusted CA certificates instead and do not use this setting outside of testing.")
+	}
+
+	var baseTransport http.RoundTripper
+	if !tlsConfig.IsEmpty() {
+		tlsTransport, err := transport.NewTLSTransport(tlsConfig)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("tls"), "Invalid TLS settings", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as there are invalid TLS settings: %s.", err.Error()))
+		} else {
+			baseTransport = tlsTransport
+		}
+	}
+
+	baseTransport = &transport.LoggingTransport{
+		BaseTransport: baseTransport,
+		Masker:        masker,
+	}
+
+	if config.Auth == nil {
+		config.Auth = &backstageProviderAuthModel{}
+	}
+
+	legacySecret := os.Getenv(envAuthLegacySecret)
+	if !config.Auth.LegacySecret.IsNull() {
+		legacySecret = config.Auth.LegacySecret.ValueString()
+	}
+
+	legacySubject := os.Getenv(envAuthLegacySubject)
+	if !config.Auth.LegacySubject.IsNull() {
+		legacySubject = config.Auth.LegacySubject.ValueString()
+	}
+
+	staticToken := os.Getenv(envAuthStaticToken)
+	if !config.Auth.StaticToken.IsNull() {
+		staticToken = config.Auth.StaticToken.ValueString()
+	}
+
+	authMethod := "none"
+	var tokenSource transport.TokenSource
+	switch {
+	case legacySecret != "" && staticToken != "":
+		resp.Diagnostics.AddAttributeError(path.Root("auth"), "Conflicting authentication methods", fmt.Sprintf(
+			"The provider cannot create the Backstage API client as both the legacy secret and the static token are set. Set only one of them in the "+
+				"configuration or via the %s and %s environment variables.", envAuthLegacySecret, envAuthStaticToken))
+	case legacySecret != "":
+		authMethod = "legacy"
+		ttl := transport.DefaultLegacyTokenTTL
+		if !config.Auth.TokenTTLSeconds.IsNull() {
+			ttl = time.Duration(config.Auth.TokenTTLSeconds.ValueInt64()) * time.Second
+		}
+
+		var err error
+		if tokenSource, err = transport.NewLegacyTokenSource(legacySecret, legacySubject, ttl); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("auth").AtName("legacy_secret"), "Invalid legacy secret", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as there is invalid value for the legacy secret: %s. Set the value in the "+
+					"configuration or use the %s environment variable.", err.Error(), envAuthLegacySecret))
+		}
+	case staticToken != "":
+		authMethod = "static"
+		tokenSource = transport.StaticTokenSource(staticToken)
+	}
+
+	if config.OAuth2 == nil {
+		config.OAuth2 = &backstageProviderOAuth2Model{}
+	}
+
+	oauth2Config := &clientcredentials.Config{
+		TokenURL:     os.Getenv(envOAuth2TokenURL),
+		ClientID:     os.Getenv(envOAuth2ClientID),
+		ClientSecret: os.Getenv(envOAuth2ClientSecret),
+	}
+	if !config.OAuth2.TokenURL.IsNull() {
+		oauth2Config.TokenURL = config.OAuth2.TokenURL.ValueString()
+	}
+	if !config.OAuth2.ClientID.IsNull() {
+		oauth2Config.ClientID = config.OAuth2.ClientID.ValueString()
+	}
+	if !config.OAuth2.ClientSecret.IsNull() {
+		oauth2Config.ClientSecret = config.OAuth2.ClientSecret.ValueString()
+	}
+
+	if scopesEnv := os.Getenv(envOAuth2Scopes); scopesEnv != "" {
+		for _, scope := range strings.Split(scopesEnv, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				oauth2Config.Scopes = append(oauth2Config.Scopes, scope)
+			}
+		}
+	}
+	if !config.OAuth2.Scopes.IsNull() {
+		oauth2Config.Scopes = nil
+		resp.Diagnostics.Append(config.OAuth2.Scopes.ElementsAs(ctx, &oauth2Config.Scopes, true)...)
+	}
+
+	audience := os.Getenv(envOAuth2Audience)
+	if !config.OAuth2.Audience.IsNull() {
+		audience = config.OAuth2.Audience.ValueString()
+	}
+	if audience != "" {
+		oauth2Config.EndpointParams = url.Values{"audience": {audience}}
+	}
+
+	if oauth2Config.TokenURL != "" || oauth2Config.ClientID != "" || oauth2Config.ClientSecret != "" {
+		if regex := regexp.MustCompile(patternURL); !regex.MatchString(oauth2Config.TokenURL) || oauth2Config.ClientID == "" || oauth2Config.ClientSecret == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("oauth2"), "Incomplete OAuth2 client credentials", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as the OAuth2 token URL, client ID and client secret must all be set. Set them in the "+
+					"configuration or use the %s, %s and %s environment variables.", envOAuth2TokenURL, envOAuth2ClientID, envOAuth2ClientSecret))
+		}
+
+		if tokenSource != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("oauth2"), "Conflicting authentication methods",
+				"The provider cannot create the Backstage API client as both OAuth2 client credentials and backend token authentication are set. Set only one of them.")
+		}
+
+		authMethod = "oauth2"
+		tokenSource = transport.NewOAuth2TokenSource(oauth2Config, &http.Client{
+			Transport: baseTransport,
+			Timeout:   time.Duration(timeoutSeconds) * time.Second,
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var maxRequestsPerSecond float64
+	if maxRequestsPerSecondStr := os.Getenv(envMaxRequestsPerSecond); maxRequestsPerSecondStr != "" {
+		var err error
+		if maxRequestsPerSecond, err = strconv.ParseFloat(maxRequestsPerSecondStr, 64); err != nil || maxRequestsPerSecond <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_requests_per_second"), "Invalid maximal number of requests per second", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as there is invalid value for the maximal number of requests per second: %s.",
+				envMaxRequestsPerSecond))
+		}
+	}
+	if !config.MaxRequestsPerSecond.IsNull() {
+		maxRequestsPerSecond = config.MaxRequestsPerSecond.ValueFloat64()
+	}
+
+	var maxConcurrentRequests int
+	if maxConcurrentRequestsStr := os.Getenv(envMaxConcurrentRequests); maxConcurrentRequestsStr != "" {
+		var err error
+		if maxConcurrentRequests, err = strconv.Atoi(maxConcurrentRequestsStr); err != nil || maxConcurrentRequests <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid maximal number of concurrent requests", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as there is invalid value for the maximal number of concurrent requests: %s.",
+				envMaxConcurrentRequests))
+		}
+	}
+	if !config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+
+	var cacheTTL time.Duration
+	cacheTTLStr := os.Getenv(envCacheTTL)
+	if !config.CacheTTL.IsNull() {
+		cacheTTLStr = config.CacheTTL.ValueString()
+	}
+	if cacheTTLStr != "" {
+		var err error
+		if cacheTTL, err = time.ParseDuration(cacheTTLStr); err != nil || cacheTTL < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("cache_ttl"), "Invalid cache TTL", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as there is invalid value for the cache TTL: %s.", cacheTTLStr))
+		}
+	}
+
+	cacheDir := os.Getenv(envCacheDir)
+	if !config.CacheDir.IsNull() {
+		cacheDir = config.CacheDir.ValueString()
+	}
+
+	var maxStale time.Duration
+	maxStaleStr := os.Getenv(envMaxStale)
+	if !config.MaxStale.IsNull() {
+		maxStaleStr = config.MaxStale.ValueString()
+	}
+	if maxStaleStr != "" {
+		var err error
+		if maxStale, err = time.ParseDuration(maxStaleStr); err != nil || maxStale < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_stale"), "Invalid maximal age of cached responses", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as there is invalid value for the maximal age of cached responses: %s.",
+				maxStaleStr))
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "backstage_base_url", baseURL)
 	ctx = tflog.SetField(ctx, "backstage_default_namespace", defaultNamespace)
-	ctx = tflog.SetField(ctx, "backstage_headers", headers)
-	ctx = tflog.SetField(ctx, "backstage_retries", retries)
+	ctx = tflog.SetField(ctx, "backstage_headers", masker.Redact(headers))
+	ctx = tflog.SetField(ctx, "backstage_retry_max_attempts", retryPolicy.MaxAttempts)
+	ctx = tflog.SetField(ctx, "backstage_max_requests_per_second", maxRequestsPerSecond)
+	ctx = tflog.SetField(ctx, "backstage_max_concurrent_requests", maxConcurrentRequests)
+	ctx = tflog.SetField(ctx, "backstage_cache_ttl", cacheTTL.String())
+	ctx = tflog.SetField(ctx, "backstage_cache_dir", cacheDir)
+	ctx = tflog.SetField(ctx, "backstage_max_stale", maxStale.String())
 	ctx = tflog.SetField(ctx, "backstage_timeout_seconds", timeoutSeconds)
+	ctx = tflog.SetField(ctx, "backstage_auth_method", authMethod)
+	ctx = tflog.SetField(ctx, "backstage_tls_insecure_skip_verify", tlsConfig.InsecureSkipVerify)
 
 	tflog.Debug(ctx, "Creating Backstage API client")
 
-	baseClient := &http.Client{}
+	if tlsConfig.InsecureSkipVerify {
+		tflog.Warn(ctx, "TLS certificate verification of Backstage API is disabled")
+	}
+
+	// Limits apply to requests to Backstage API only, so they are not shared with the token endpoint.
+	catalogTransport := baseTransport
+	if maxRequestsPerSecond > 0 || maxConcurrentRequests > 0 {
+		catalogTransport = transport.NewLimitTransport(maxRequestsPerSecond, maxConcurrentRequests, baseTransport)
+	}
+
+	baseClient := &http.Client{Transport: catalogTransport}
 	baseClient.Timeout = time.Duration(timeoutSeconds) * time.Second
 
-	if retries > 0 {
-		retryableClient := retryablehttp.NewClient()
-		retryableClient.RetryMax = retries
-		retryableClient.HTTPClient.Timeout = baseClient.Timeout
-		baseClient = retryableClient.StandardClient()
+	if retryPolicy.MaxAttempts > 1 {
+		baseClient = transport.NewRetryableClient(retryPolicy, catalogTransport, baseClient.Timeout)
+	}
+
+	if tokenSource != nil {
+		baseClient.Transport = &transport.TokenTransport{
+			BaseTransport: baseClient.Transport,
+			TokenSource:   tokenSource,
+		}
 	}
 
 	baseClient.Transport = &transport.HeadersTransport{
@@ -203,26 +734,49 @@ func (p *backstageProvider) Configure(ctx context.Context, req provider.Configur
 		Headers:       headers,
 	}
 
-	client, err := backstage.NewClient(baseURL, defaultNamespace, baseClient)
+	if cacheDir != "" {
+		diskCacheTransport, err := transport.NewDiskCacheTransport(cacheDir, maxStale, baseClient.Transport)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("cache_dir"), "Unable to use cache directory", fmt.Sprintf(
+				"The provider cannot create the Backstage API client as the cache directory %s cannot be used: %s", cacheDir, err.Error()))
+			return
+		}
+		baseClient.Transport = diskCacheTransport
+	}
+
+	// Cache is the outermost layer, so cached responses skip authentication, retries and limits altogether.
+	if cacheTTL > 0 {
+		baseClient.Transport = transport.NewCacheTransport(cacheTTL, baseClient.Transport)
+	}
+
+	apiClient, err := client.NewClient(baseURL, defaultNamespace, baseClient)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create Backstage API client",
 			fmt.Sprintf("An unexpected error occurred when creating the Backstage API client: %s", err.Error()),
 		)
 	}
 
-	resp.ResourceData = client
-	resp.DataSourceData = client
+	resp.ResourceData = apiClient
+	resp.DataSourceData = apiClient
 }
 
 func (p *backstageProvider) Resources(context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewLocationResource,
+		NewScaffolderTaskResource,
+		NewEntityRefreshResource,
 	}
 }
 
 func (p *backstageProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewEntityDataSource,
+		NewGenericEntityDataSource,
+		NewEntityFacetsDataSource,
+		NewEntitiesByRefsDataSource,
+		NewEntityAncestryDataSource,
+		NewEntityValidationDataSource,
+		NewLocationAnalysisDataSource,
 		NewApiDataSource,
 		NewComponentDataSource,
 		NewDomainDataSource,
@@ -231,6 +785,7 @@ func (p *backstageProvider) DataSources(context.Context) []func() datasource.Dat
 		NewResourceDataSource,
 		NewSystemDataSource,
 		NewUserDataSource,
+		NewTemplateDataSource,
 	}
 }
 
@@ -242,3 +797,60 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// addStaleResponseWarning adds a warning to the diagnostics, if the response was served from the cache directory instead of the Backstage API.
+func addStaleResponseWarning(diags *diag.Diagnostics, response *http.Response, subject string) {
+	age, ok := transport.StaleAge(response)
+	if !ok {
+		return
+	}
+
+	diags.AddWarning("Using cached Backstage "+subject, fmt.Sprintf(
+		"Backstage API is unavailable, so the last known %s cached %s ago is used instead.", subject, age))
+}
+
+// parseHeaders parses headers provided via environment variable, either as a JSON object, or as comma separated key=value pairs with optionally
+// quoted values (e.g. `key1=value1,key2="value=2,with comma"`).
+func parseHeaders(s string) (map[string]string, error) {
+	headers := make(map[string]string)
+
+	if s = strings.TrimSpace(s); strings.HasPrefix(s, "{") {
+		if err := json.Unmarshal([]byte(s), &headers); err != nil {
+			return nil, fmt.Errorf("invalid JSON object: %w", err)
+		}
+
+		return headers, nil
+	}
+
+	for s != "" {
+		key, rest, found := strings.Cut(s, "=")
+		if key = strings.TrimSpace(key); !found || key == "" {
+			return nil, fmt.Errorf("expected key=value pair, got %q", s)
+		}
+
+		var value string
+		if rest = strings.TrimLeft(rest, " "); strings.HasPrefix(rest, `"`) {
+			quoted, err := strconv.QuotedPrefix(rest)
+			if err != nil {
+				return nil, fmt.Errorf("unterminated quoted value of header %q", key)
+			}
+
+			if value, err = strconv.Unquote(quoted); err != nil {
+				return nil, fmt.Errorf("invalid quoted value of header %q: %w", key, err)
+			}
+
+			rest = strings.TrimLeft(rest[len(quoted):], " ")
+			if rest != "" && !strings.HasPrefix(rest, ",") {
+				return nil, fmt.Errorf("unexpected characters after quoted value of header %q", key)
+			}
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+			value, rest = strings.TrimSpace(value), ","+rest
+		}
+
+		headers[key] = value
+		s = strings.TrimPrefix(rest, ",")
+	}
+
+	return headers, nil
+}
@@ -1,8 +1,19 @@
 package backstage
 
 import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
 )
 
 const testAccProviderConfig = `
@@ -18,3 +29,85 @@ provider "backstage" {
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"backstage": providerserver.NewProtocol6WithError(New("test")()),
 }
+
+func TestParseHeaders(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected map[string]string
+		err      bool
+	}{
+		"key-value pairs": {
+			input:    "Custom-Header=header_value, Other-Header = other value",
+			expected: map[string]string{"Custom-Header": "header_value", "Other-Header": "other value"},
+		},
+		"value with equal signs": {
+			input:    "Authorization=Basic dXNlcjpwYXNz==,Custom-Header=header_value",
+			expected: map[string]string{"Authorization": "Basic dXNlcjpwYXNz==", "Custom-Header": "header_value"},
+		},
+		"quoted value with commas": {
+			input:    `Cookie="a=1, b=2",Custom-Header="say \"hi\""`,
+			expected: map[string]string{"Cookie": "a=1, b=2", "Custom-Header": `say "hi"`},
+		},
+		"empty value": {
+			input:    "Custom-Header=",
+			expected: map[string]string{"Custom-Header": ""},
+		},
+		"JSON object": {
+			input:    `{"Cookie": "a=1,b=2", "Custom-Header": "header_value"}`,
+			expected: map[string]string{"Cookie": "a=1,b=2", "Custom-Header": "header_value"},
+		},
+		"invalid JSON object":    {input: `{"Custom-Header": 1}`, err: true},
+		"missing value":          {input: "Custom-Header", err: true},
+		"missing key":            {input: "=header_value", err: true},
+		"unterminated quote":     {input: `Cookie="a=1,b=2`, err: true},
+		"characters after quote": {input: `Cookie="a=1"b=2`, err: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			headers, err := parseHeaders(test.input)
+			if test.err {
+				assert.Errorf(t, err, "parseHeaders should return an error for %q", test.input)
+				return
+			}
+
+			assert.NoErrorf(t, err, "parseHeaders should not return an error for %q", test.input)
+			assert.Equalf(t, test.expected, headers, "parseHeaders should parse %q", test.input)
+		})
+	}
+}
+
+func TestAddStaleResponseWarning(t *testing.T) {
+	var diags diag.Diagnostics
+	addStaleResponseWarning(&diags, &http.Response{Header: http.Header{}}, "Component kind default/test")
+	assert.Emptyf(t, diags, "Live response should not produce a warning")
+
+	addStaleResponseWarning(&diags, &http.Response{Header: http.Header{transport.HeaderStaleAge: []string{"5400"}}}, "Component kind default/test")
+	if assert.Lenf(t, diags, 1, "Stale response should produce a warning") {
+		assert.Equalf(t, diag.SeverityWarning, diags[0].Severity(), "Diagnostic should be a warning")
+		assert.Containsf(t, diags[0].Detail(), "cached 1h30m0s ago", "Warning should state the age of the response")
+	}
+}
+
+func TestProviderConfigure_InvalidHeadersEnv(t *testing.T) {
+	t.Setenv(envHeaders, "Custom-Header")
+
+	p := New("test")()
+	var schemaResp provider.SchemaResponse
+	p.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+
+	headers, diags := types.MapValueFrom(context.Background(), types.StringType, map[string]string{"Other-Header": "header_value"})
+	assert.Emptyf(t, diags, "Headers should be created")
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}
+	assert.Emptyf(t, state.Set(context.Background(), &backstageProviderModel{BaseURL: types.StringValue("https://backstage.example.com"),
+		Headers: headers}), "Config should be set")
+
+	var resp provider.ConfigureResponse
+	assert.NotPanicsf(t, func() {
+		p.Configure(context.Background(), provider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &resp)
+	}, "Configure should not panic")
+	if assert.Truef(t, resp.Diagnostics.HasError(), "Configure should return an error") {
+		assert.Equalf(t, "Invalid headers", resp.Diagnostics.Errors()[0].Summary(), "Error should report the invalid headers")
+	}
+}
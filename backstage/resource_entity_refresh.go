@@ -0,0 +1,157 @@
+package backstage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &entityRefreshResource{}
+	_ resource.ResourceWithConfigure = &entityRefreshResource{}
+)
+
+// NewEntityRefreshResource is a helper function to simplify the provider implementation.
+func NewEntityRefreshResource() resource.Resource {
+	return &entityRefreshResource{}
+}
+
+// entityRefreshResource is the resource implementation.
+type entityRefreshResource struct {
+	client *client.Client
+}
+
+// entityRefreshResourceModel maps the resource schema data.
+type entityRefreshResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	EntityRef     types.String `tfsdk:"entity_ref"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	LastRefreshed types.String `tfsdk:"last_refreshed"`
+}
+
+const (
+	descriptionEntityRefreshID        = "Reference of the refreshed entity in the `<kind>:<namespace>/<name>` format."
+	descriptionEntityRefreshEntityRef = "Reference of the entity to refresh in the `<kind>:[<namespace>/]<name>` format, e.g. " +
+		"`location:default/generated-0123456789abcdef` or `component:artist-web`. The namespace defaults to the one configured in the provider."
+	descriptionEntityRefreshTriggers = "Arbitrary map of values, which triggers a new refresh, whenever any of them changes, e.g. the hash " +
+		"of the catalog file."
+	descriptionEntityRefreshLastRefreshed = "Timestamp of the last refresh triggered by Terraform."
+)
+
+// Metadata returns the data source type name.
+func (r *entityRefreshResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_refresh"
+}
+
+// Schema defines the schema for the resource.
+func (r *entityRefreshResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to schedule a refresh of an entity in Backstage Software Catalog, e.g. to re-read a location " +
+			"after its catalog file was changed. The refresh is triggered on creation and whenever `entity_ref` or any of `triggers` changes, " +
+			"similarly to `null_resource`. Destroying the resource does not change anything in Backstage.\n\n" +
+			"The refresh is processed by Backstage asynchronously, so the changes may not be visible right after the apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, MarkdownDescription: descriptionEntityRefreshID, PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			}},
+			"entity_ref": schema.StringAttribute{Required: true, MarkdownDescription: descriptionEntityRefreshEntityRef,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
+			"triggers": schema.MapAttribute{Optional: true, ElementType: types.StringType, MarkdownDescription: descriptionEntityRefreshTriggers,
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()}},
+			"last_refreshed": schema.StringAttribute{Computed: true, Description: descriptionEntityRefreshLastRefreshed,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *entityRefreshResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
+
+// Create schedules a refresh of the entity and sets the initial Terraform state.
+func (r *entityRefreshResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan entityRefreshResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ref, err := client.ParseEntityRef(plan.EntityRef.ValueString(), "", r.client.DefaultNamespace)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("entity_ref"), "Invalid entity reference", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Refreshing entity %s with Backstage API", ref))
+	response, err := r.client.RefreshEntity(ctx, ref.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error refreshing Backstage entity",
+			fmt.Sprintf("Could not refresh entity %s, unexpected error: %s", ref, err.Error()),
+		)
+		return
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(path.Root("entity_ref"), "Error refreshing Backstage entity",
+			fmt.Sprintf("Could not refresh entity %s, as it does not exist in the catalog.", ref),
+		)
+		return
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		resp.Diagnostics.AddError("Error refreshing Backstage entity",
+			fmt.Sprintf("Could not refresh entity %s, unexpected status code: %d", ref, response.StatusCode),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(ref.String())
+	plan.LastRefreshed = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read keeps the Terraform state, as there is nothing to read back from a refresh.
+func (r *entityRefreshResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update keeps the Terraform state, as any change of the configuration triggers a new refresh by replacing the resource.
+func (r *entityRefreshResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan entityRefreshResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the Terraform state, as a refresh cannot be undone.
+func (r *entityRefreshResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
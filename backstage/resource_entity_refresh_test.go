@@ -0,0 +1,79 @@
+package backstage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceEntityRefresh(t *testing.T) {
+	var refreshes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/catalog/refresh" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body struct {
+			EntityRef string `json:"entityRef"`
+		}
+		assert.NoErrorf(t, json.NewDecoder(r.Body).Decode(&body), "Request body should be decoded")
+		assert.Equalf(t, "location:default/generated-1", body.EntityRef, "Request entity ref should be normalized")
+		refreshes.Add(1)
+	}))
+	t.Cleanup(server.Close)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceEntityRefreshConfig, server.URL, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("backstage_entity_refresh.test", "id", "location:default/generated-1"),
+					resource.TestCheckResourceAttr("backstage_entity_refresh.test", "triggers.version", "v1"),
+					resource.TestCheckResourceAttrSet("backstage_entity_refresh.test", "last_refreshed"),
+					func(_ *terraform.State) error {
+						return assertRefreshes(&refreshes, 1)
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccResourceEntityRefreshConfig, server.URL, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("backstage_entity_refresh.test", "triggers.version", "v2"),
+					func(_ *terraform.State) error {
+						return assertRefreshes(&refreshes, 2)
+					},
+				),
+			},
+		},
+	})
+}
+
+func assertRefreshes(refreshes *atomic.Int32, expected int32) error {
+	if actual := refreshes.Load(); actual != expected {
+		return fmt.Errorf("expected %d refreshes, got %d", expected, actual)
+	}
+
+	return nil
+}
+
+const testAccResourceEntityRefreshConfig = `
+provider "backstage" {
+  base_url = "%s"
+}
+
+resource "backstage_entity_refresh" "test" {
+  entity_ref = "Location:generated-1"
+  triggers = {
+    version = "%s"
+  }
+}
+`
@@ -4,16 +4,25 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
@@ -29,22 +38,58 @@ func NewLocationResource() resource.Resource {
 
 // locationResource is the resource implementation.
 type locationResource struct {
-	client *backstage.Client
+	client *client.Client
+
+	// pollInterval is the interval to poll the catalog for entities of the location in. It defaults to defaultLocationPollInterval.
+	pollInterval time.Duration
 }
 
 // locationResourceModel maps the resource schema data.
 type locationResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Type        types.String `tfsdk:"type"`
-	Target      types.String `tfsdk:"target"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID                     types.String           `tfsdk:"id"`
+	Type                   types.String           `tfsdk:"type"`
+	Target                 types.String           `tfsdk:"target"`
+	Presence               types.String           `tfsdk:"presence"`
+	LastUpdated            types.String           `tfsdk:"last_updated"`
+	AnalyzeBeforeCreate    types.Bool             `tfsdk:"analyze_before_create"`
+	WaitForEntities        types.Bool             `tfsdk:"wait_for_entities"`
+	FailOnProcessingErrors types.Bool             `tfsdk:"fail_on_processing_errors"`
+	DeleteOrphansOnDestroy types.Bool             `tfsdk:"delete_orphans_on_destroy"`
+	Timeouts               *locationTimeoutsModel `tfsdk:"timeouts"`
+	EntityRefs             types.List             `tfsdk:"entity_refs"`
+}
+
+type locationTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
 }
 
 const (
-	descriptionLocationID          = "Identifier of the location."
-	descriptionLocationType        = "Type of the location. Always `url`."
-	descriptionLocationTarget      = "Target as a string. Should be a valid URL."
-	descriptionLocationLastUpdated = "Timestamp of the last Terraform update of the location."
+	patternLocationType = `^[a-zA-Z][a-zA-Z0-9-]*$`
+
+	annotationManagedByLocation = "backstage.io/managed-by-location"
+
+	defaultLocationPollInterval  = 5 * time.Second
+	defaultLocationCreateTimeout = 5 * time.Minute
+
+	descriptionLocationID       = "Identifier of the location."
+	descriptionLocationType     = "Type of the location: `url` (default), `file` or a custom type supported by the catalog processors."
+	descriptionLocationTarget   = "Target as a string, e.g. a valid URL for `url` type or an absolute path on the Backstage host for `file` type."
+	descriptionLocationPresence = "Whether the target must exist: `required` (default) or `optional`. Optional locations may point to " +
+		"targets, which do not exist yet. Imported locations are assumed to be `required`, as the presence is not returned by the API."
+	descriptionLocationLastUpdated         = "Timestamp of the last Terraform update of the location."
+	descriptionLocationAnalyzeBeforeCreate = "Whether to analyze the target before registering it and fail, if no entities would be discovered " +
+		"in it (default: `false`). See `backstage_location_analysis` data source for details of the analysis."
+	descriptionLocationWaitForEntities = "Whether to wait for the entities of the location to be ingested into the catalog after its " +
+		"registration (default: `false`), so that they can be read by other data sources in the same run."
+	descriptionLocationFailOnProcessingErrors = "Whether to also wait for the entities to have no processing errors and fail, if they still " +
+		"have them after the timeout (default: `false`). Only applies, if `wait_for_entities` is set."
+	descriptionLocationDeleteOrphansOnDestroy = "Whether to also delete the entities emitted by the location, when it is destroyed " +
+		"(default: `false`). Otherwise they are left in the catalog as orphans. The setting must be applied before the destroy to take effect. " +
+		"Entities are not deleted for targets containing a comma, as they cannot be looked up reliably."
+	descriptionLocationTimeouts       = "Timeouts of the location operations."
+	descriptionLocationTimeoutsCreate = "Time to wait for the entities of the location for, e.g. `10m` (default: `5m`)."
+	descriptionLocationEntityRefs     = "References of the entities emitted by the location at its registration, in the " +
+		"`<kind>:<namespace>/<name>` format. Only known, if `wait_for_entities` is set."
 )
 
 // Metadata returns the data source type name.
@@ -62,12 +107,32 @@ func (r *locationResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"id": schema.StringAttribute{Computed: true, Description: descriptionLocationID, PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			}},
-			"type": schema.StringAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionLocationType, Validators: []validator.String{}, PlanModifiers: []planmodifier.String{
+			"type": schema.StringAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionLocationType,
+				Default: stringdefault.StaticString(defaultLocationType), Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(patternLocationType), "must start with a letter and contain only letters, digits and dashes"),
+				}, PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
+			"target": schema.StringAttribute{Required: true, MarkdownDescription: descriptionLocationTarget,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
+			"presence": schema.StringAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionLocationPresence,
+				Default: stringdefault.StaticString(client.LocationPresenceRequired), Validators: []validator.String{
+					stringvalidator.OneOf(client.LocationPresenceRequired, client.LocationPresenceOptional),
+				}, PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
+			"last_updated": schema.StringAttribute{Computed: true, Description: descriptionLocationLastUpdated, PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			}},
-			"target": schema.StringAttribute{Required: true, Description: descriptionLocationTarget,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
-			"last_updated": schema.StringAttribute{Computed: true, Description: descriptionLocationLastUpdated},
+			"analyze_before_create":     schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionLocationAnalyzeBeforeCreate},
+			"wait_for_entities":         schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionLocationWaitForEntities},
+			"fail_on_processing_errors": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionLocationFailOnProcessingErrors},
+			"delete_orphans_on_destroy": schema.BoolAttribute{Optional: true, MarkdownDescription: descriptionLocationDeleteOrphansOnDestroy},
+			"timeouts": schema.SingleNestedAttribute{Optional: true, Description: descriptionLocationTimeouts, Attributes: map[string]schema.Attribute{
+				"create": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionLocationTimeoutsCreate, Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(patternDuration), "must be a valid duration, e.g. 500ms, 10s or 1m"),
+				}},
+			}},
+			"entity_refs": schema.ListAttribute{Computed: true, MarkdownDescription: descriptionLocationEntityRefs, ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				}},
 		},
 	}
 }
@@ -78,7 +143,7 @@ func (r *locationResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = req.ProviderData.(*backstage.Client)
+	r.client = req.ProviderData.(*client.Client)
 }
 
 // Create registers a new location in Backstage and sets the initial Terraform state.
@@ -90,7 +155,76 @@ func (r *locationResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	location, response, err := r.client.Catalog.Locations.Create(ctx, plan.Target.ValueString(), false)
+	locationType := defaultLocationType
+	if !plan.Type.IsUnknown() && !plan.Type.IsNull() {
+		locationType = plan.Type.ValueString()
+	}
+
+	createTimeout := defaultLocationCreateTimeout
+	if plan.Timeouts != nil && !plan.Timeouts.Create.IsNull() {
+		var err error
+		if createTimeout, err = time.ParseDuration(plan.Timeouts.Create.ValueString()); err != nil || createTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("create"), "Invalid create timeout",
+				fmt.Sprintf("Could not parse create timeout %q, it must be a positive duration.", plan.Timeouts.Create.ValueString()))
+			return
+		}
+	}
+
+	locationOptions := &client.CreateLocationOptions{
+		Type:     locationType,
+		Target:   plan.Target.ValueString(),
+		Presence: plan.Presence.ValueString(),
+	}
+
+	if plan.AnalyzeBeforeCreate.ValueBool() {
+		analysis, response, err := r.client.AnalyzeLocation(ctx, &client.AnalyzeLocationOptions{
+			Location: client.LocationSpec{Type: locationType, Target: plan.Target.ValueString()},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error analyzing location",
+				fmt.Sprintf("Could not analyze location %s, unexpected error: %s", plan.Target.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		if response.StatusCode != http.StatusOK {
+			resp.Diagnostics.AddError("Error analyzing location",
+				fmt.Sprintf("Could not analyze location %s, unexpected status code: %d", plan.Target.ValueString(), response.StatusCode),
+			)
+			return
+		}
+
+		if len(analysis.ExistingEntityFiles) == 0 && len(analysis.GenerateEntities) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("target"), "Location yields no entities",
+				fmt.Sprintf("Analysis of location %s found no entities, so it was not registered. Make sure the target points to a valid "+
+					"catalog file or repository.", plan.Target.ValueString()),
+			)
+			return
+		}
+	}
+
+	// Entities are not returned when the location is registered, so they are discovered by a dry run beforehand.
+	entityRefs := []string{}
+	if plan.WaitForEntities.ValueBool() {
+		dryRun, response, err := r.client.CreateLocation(ctx, locationOptions, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating location",
+				fmt.Sprintf("Could not discover entities of location %s, unexpected error: %s", plan.Target.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+			resp.Diagnostics.AddError("Error creating location",
+				fmt.Sprintf("Could not discover entities of location %s, unexpected status code: %d", plan.Target.ValueString(), response.StatusCode),
+			)
+			return
+		}
+
+		entityRefs = appendEntityRefs(entityRefs, dryRun.Entities)
+	}
+
+	location, response, err := r.client.CreateLocation(ctx, locationOptions, false)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating location",
 			fmt.Sprintf("Could not create location, unexpected error: %s", err.Error()),
@@ -109,12 +243,38 @@ func (r *locationResource) Create(ctx context.Context, req resource.CreateReques
 	plan.Type = types.StringValue(location.Location.Type)
 	plan.Target = types.StringValue(location.Location.Target)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.EntityRefs = types.ListNull(types.StringType)
+
+	if !plan.WaitForEntities.ValueBool() {
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	entityRefs = appendEntityRefs(entityRefs, location.Entities)
+	plan.EntityRefs, diags = types.ListValueFrom(ctx, types.StringType, entityRefs)
+	resp.Diagnostics.Append(diags...)
 
+	// The location exists regardless of the outcome of waiting, so it is kept in the state, where a failure taints it.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	missing, statusDiags, err := r.waitForEntities(waitCtx, entityRefs, plan.FailOnProcessingErrors.ValueBool())
+	if err != nil {
+		detail := fmt.Sprintf("Could not wait for entities of location %s: %s", plan.ID.ValueString(), err.Error())
+		if len(missing) > 0 {
+			detail += fmt.Sprintf(". Entities not found in the catalog: %s", strings.Join(missing, ", "))
+		}
+
+		resp.Diagnostics.AddError("Error waiting for entities of location", detail)
+		resp.Diagnostics.Append(statusDiags...)
+	}
 }
 
 // Read reads the existing location and refreshes the Terraform state with the latest data.
@@ -126,7 +286,7 @@ func (r *locationResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	location, response, err := r.client.Catalog.Locations.GetByID(ctx, state.ID.ValueString())
+	location, response, err := r.client.GetLocation(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading Backstage location",
 			fmt.Sprintf("Could not read Backstage location ID %s: %s", state.ID.ValueString(), err.Error()),
@@ -134,6 +294,13 @@ func (r *locationResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// Location deleted outside of Terraform is removed from the state, so that it is planned for re-creation.
+	if response.StatusCode == http.StatusNotFound {
+		tflog.Warn(ctx, "Backstage location not found, removing it from state", map[string]interface{}{"backstage_location_id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if response.StatusCode != http.StatusOK {
 		resp.Diagnostics.AddError("Error reading Backstage location",
 			fmt.Sprintf("Could not read Backstage location ID %s, unexpected status code: %d", state.ID.ValueString(), response.StatusCode),
@@ -144,6 +311,11 @@ func (r *locationResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.Target = types.StringValue(location.Target)
 	state.Type = types.StringValue(location.Type)
 
+	// Presence is not returned by the API, so imported locations are assumed to have the default one.
+	if state.Presence.IsNull() {
+		state.Presence = types.StringValue(client.LocationPresenceRequired)
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -151,9 +323,9 @@ func (r *locationResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 }
 
-// Update updates the resource and sets the updated Terraform state on success.
+// Update updates the resource and sets the updated Terraform state on success. Changes of the location itself (i.e. its type and target)
+// require replacement, so only the settings of the resource, which are not stored in Backstage, are updated and the location is kept as is.
 func (r *locationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
 	var plan locationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -167,6 +339,18 @@ func (r *locationResource) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	plan.ID = state.ID
+	plan.Type = state.Type
+	plan.Target = state.Target
+	plan.LastUpdated = state.LastUpdated
+	plan.EntityRefs = state.EntityRefs
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete deletes the location and removes the Terraform state on success.
@@ -178,19 +362,40 @@ func (r *locationResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// An already deleted location is not an error, but its orphans may still be left from a failed destroy.
 	response, err := r.client.Catalog.Locations.DeleteByID(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting Backstage location",
-			fmt.Sprintf("Could not delete location, unexpected error: %s", err.Error()),
-		)
+	if response == nil || response.StatusCode != http.StatusNotFound {
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting Backstage location",
+				fmt.Sprintf("Could not delete location, unexpected error: %s", err.Error()),
+			)
+			return
+		}
+
+		if response.StatusCode != http.StatusNoContent {
+			resp.Diagnostics.AddError("Error deleting Backstage location",
+				fmt.Sprintf("Could not delete location, unexpected status code: %d", response.StatusCode),
+			)
+			return
+		}
+	}
+
+	if !state.DeleteOrphansOnDestroy.ValueBool() {
 		return
 	}
 
-	if response.StatusCode != http.StatusNoContent {
-		resp.Diagnostics.AddError("Error deleting Backstage location",
-			fmt.Sprintf("Could not delete location, unexpected status code: %d", response.StatusCode),
+	locationType := defaultLocationType
+	if state.Type.ValueString() != "" {
+		locationType = state.Type.ValueString()
+	}
+
+	locationRef := fmt.Sprintf("%s:%s", locationType, state.Target.ValueString())
+	deleted, diags := r.deleteManagedEntities(ctx, locationRef)
+	resp.Diagnostics.Append(diags...)
+	if len(deleted) > 0 {
+		resp.Diagnostics.AddWarning("Deleted entities of Backstage location",
+			fmt.Sprintf("Entities managed by location %s were deleted from the catalog: %s", locationRef, strings.Join(deleted, ", ")),
 		)
-		return
 	}
 }
 
@@ -198,3 +403,145 @@ func (r *locationResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *locationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// waitForEntities polls the catalog, until all the entities exist and, if requested, have no processing errors, or the context is done.
+// It returns references of the entities that were still missing and the processing errors found by the last poll.
+func (r *locationResource) waitForEntities(ctx context.Context, refs []string, checkErrors bool) ([]string, diag.Diagnostics, error) {
+	if len(refs) == 0 {
+		return nil, nil, nil
+	}
+
+	interval := r.pollInterval
+	if interval <= 0 {
+		interval = defaultLocationPollInterval
+	}
+
+	for {
+		entities, response, err := r.client.GetEntitiesByRefs(ctx, refs, nil)
+		if err != nil {
+			return refs, nil, err
+		}
+
+		if response.StatusCode != http.StatusOK {
+			return refs, nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+		}
+
+		var missing []string
+		var statusDiags diag.Diagnostics
+		for i, ref := range refs {
+			if i >= len(entities.Items) || entities.Items[i] == nil {
+				missing = append(missing, ref)
+				continue
+			}
+
+			if checkErrors {
+				addEntityStatusErrors(&statusDiags, entities.Items[i].Status, fmt.Sprintf("entity %s", ref))
+			}
+		}
+
+		if len(missing) == 0 && !statusDiags.HasError() {
+			return nil, nil, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for entities of Backstage location", map[string]interface{}{
+			"backstage_missing_entities": missing,
+			"backstage_entity_errors":    statusDiags.ErrorsCount(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return missing, statusDiags, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// appendEntityRefs appends references of the entities to the refs, skipping the ones already present.
+func appendEntityRefs(refs []string, entities []backstage.Entity) []string {
+	for i := range entities {
+		ref := newAnalyzedEntityRef(&entities[i])
+		if !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs
+}
+
+// deleteManagedEntities deletes the entities, whose managed-by-location annotation equals the location reference in the <type>:<target>
+// format. References with a comma are skipped with a warning, as they cannot be used in a filter. It returns the references of the
+// deleted entities.
+func (r *locationResource) deleteManagedEntities(ctx context.Context, locationRef string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var entities []backstage.Entity
+
+	// Filters separate their conditions by commas, so such a location cannot be looked up reliably.
+	if strings.Contains(locationRef, ",") {
+		diags.AddWarning("Entities of Backstage location not deleted",
+			fmt.Sprintf("Entities managed by location %s were not deleted, as its reference contains a comma, which cannot be used in a "+
+				"filter of entities. Delete the orphaned entities manually.", locationRef),
+		)
+		return nil, diags
+	}
+
+	options := &client.QueryEntitiesOptions{
+		Filters: []string{fmt.Sprintf("metadata.annotations.%s=%s", annotationManagedByLocation, locationRef)},
+		Fields:  []string{"kind", "metadata.namespace", "metadata.name", "metadata.uid", "metadata.annotations"},
+	}
+	for {
+		page, response, err := r.client.QueryEntities(ctx, options)
+		if err != nil {
+			diags.AddError("Error reading entities of Backstage location",
+				fmt.Sprintf("Could not query entities of location %s: %s", locationRef, err.Error()),
+			)
+			return nil, diags
+		}
+
+		if response.StatusCode != http.StatusOK {
+			diags.AddError("Error reading entities of Backstage location",
+				fmt.Sprintf("Could not query entities of location %s, unexpected status code: %d", locationRef, response.StatusCode),
+			)
+			return nil, diags
+		}
+
+		entities = append(entities, page.Items...)
+		if page.PageInfo.NextCursor == "" {
+			break
+		}
+		options = &client.QueryEntitiesOptions{Cursor: page.PageInfo.NextCursor, Fields: options.Fields}
+	}
+
+	var deleted []string
+	for _, e := range entities {
+		// The filter only pre-selects the entities, so that a loose match never deletes an entity of another location.
+		if e.Metadata.Annotations[annotationManagedByLocation] != locationRef {
+			continue
+		}
+
+		ref := newAnalyzedEntityRef(&e)
+		tflog.Debug(ctx, fmt.Sprintf("Deleting entity %s of location %s with Backstage API", ref, locationRef))
+
+		response, err := r.client.Catalog.Entities.Delete(ctx, e.Metadata.UID)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError("Error deleting entity of Backstage location",
+				fmt.Sprintf("Could not delete entity %s of location %s, unexpected error: %s", ref, locationRef, err.Error()),
+			)
+			continue
+		}
+
+		if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
+			diags.AddError("Error deleting entity of Backstage location",
+				fmt.Sprintf("Could not delete entity %s of location %s, unexpected status code: %d", ref, locationRef, response.StatusCode),
+			)
+			continue
+		}
+
+		deleted = append(deleted, ref)
+	}
+
+	return deleted, diags
+}
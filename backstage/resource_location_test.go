@@ -3,10 +3,29 @@
 package backstage
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceLocation(t *testing.T) {
@@ -57,3 +76,377 @@ resource "backstage_location" "test" {
   target = "http://test2"
 }
 `
+
+func TestAccResourceLocation_AnalyzeBeforeCreate(t *testing.T) {
+	if os.Getenv("ACCTEST_SKIP_RESOURCE_TEST") != "" {
+		t.Skip("Skipping as ACCTEST_SKIP_RESOURCE_LOCATION is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig + testAccResourceLocationConfigAnalyzed,
+				ExpectError: regexp.MustCompile("Error analyzing location|Location yields no entities"),
+			},
+		},
+	})
+}
+
+const testAccResourceLocationConfigAnalyzed = `
+resource "backstage_location" "test" {
+  target                = "http://test1"
+  analyze_before_create = true
+}
+`
+
+// newLocationStubServer returns a server stubbing the locations API of Backstage catalog, backed by the given locations keyed by ID.
+func newLocationStubServer(t *testing.T, locations map[string]backstage.LocationResponse) *client.Client {
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/catalog/locations/")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/catalog/locations":
+			var body backstage.LocationResponse
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			body.ID = fmt.Sprintf("location-%d", len(locations)+1)
+			locations[body.ID] = body
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(backstage.LocationCreateResponse{Location: &body, Entities: []backstage.Entity{}})
+		case r.Method == http.MethodGet && locations[id].ID != "":
+			_ = json.NewEncoder(w).Encode(locations[id])
+		case r.Method == http.MethodDelete && locations[id].ID != "":
+			delete(locations, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"name":"NotFoundError","message":"Location not found"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+
+	return c
+}
+
+// newLocationResourceState returns Terraform state of the location resource holding the model.
+func newLocationResourceState(t *testing.T, r *locationResource, model *locationResourceModel) tfsdk.State {
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	if model.EntityRefs.ElementType(context.Background()) == nil {
+		model.EntityRefs = types.ListNull(types.StringType)
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}
+	assert.Emptyf(t, state.Set(context.Background(), model), "State should be set")
+
+	return state
+}
+
+func TestLocationResource_CreateAndDelete(t *testing.T) {
+	locations := map[string]backstage.LocationResponse{}
+	r := &locationResource{client: newLocationStubServer(t, locations)}
+
+	plan := newLocationResourceState(t, r, &locationResourceModel{ID: types.StringUnknown(), Type: types.StringUnknown(),
+		Target: types.StringValue("http://test1"), LastUpdated: types.StringUnknown(), AnalyzeBeforeCreate: types.BoolNull()})
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: tfsdk.Plan(plan)}, &createResp)
+	assert.Emptyf(t, createResp.Diagnostics, "Create should not return diagnostics")
+
+	var created locationResourceModel
+	createResp.State.Get(context.Background(), &created)
+	assert.Equalf(t, "location-1", created.ID.ValueString(), "Location ID should be set")
+	assert.Equalf(t, "url", created.Type.ValueString(), "Location type should be set")
+	assert.Falsef(t, created.LastUpdated.IsUnknown(), "Last updated should be set")
+
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+	assert.Emptyf(t, deleteResp.Diagnostics, "Delete should not return diagnostics")
+	assert.Emptyf(t, locations, "Location should be deleted")
+
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+	assert.Emptyf(t, deleteResp.Diagnostics, "Delete of already deleted location should not return diagnostics")
+}
+
+func TestLocationResource_Read(t *testing.T) {
+	locations := map[string]backstage.LocationResponse{"location-1": {ID: "location-1", Type: "url", Target: "http://changed"}}
+	r := &locationResource{client: newLocationStubServer(t, locations)}
+	model := &locationResourceModel{ID: types.StringValue("location-1"), Type: types.StringValue("url"), Target: types.StringValue("http://test1"),
+		LastUpdated: types.StringValue("Monday, 01-Jan-24 00:00:00 UTC"), AnalyzeBeforeCreate: types.BoolNull()}
+
+	state := newLocationResourceState(t, r, model)
+	readResp := fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, &readResp)
+	assert.Emptyf(t, readResp.Diagnostics, "Read should not return diagnostics")
+
+	var read locationResourceModel
+	readResp.State.Get(context.Background(), &read)
+	assert.Equalf(t, "http://changed", read.Target.ValueString(), "Target changed outside of Terraform should be detected")
+	assert.Equalf(t, model.LastUpdated, read.LastUpdated, "Last updated should not change on read")
+	assert.Equalf(t, "required", read.Presence.ValueString(), "Presence of imported location should default to required")
+
+	delete(locations, "location-1")
+	readResp = fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, &readResp)
+	assert.Emptyf(t, readResp.Diagnostics, "Read of deleted location should not return diagnostics")
+	assert.Truef(t, readResp.State.Raw.IsNull(), "Deleted location should be removed from state")
+}
+
+func TestLocationResource_Update(t *testing.T) {
+	r := &locationResource{client: newLocationStubServer(t, map[string]backstage.LocationResponse{})}
+	state := newLocationResourceState(t, r, &locationResourceModel{ID: types.StringValue("location-1"), Type: types.StringValue("url"),
+		Target: types.StringValue("http://test1"), LastUpdated: types.StringValue("Monday, 01-Jan-24 00:00:00 UTC"), AnalyzeBeforeCreate: types.BoolNull()})
+	plan := newLocationResourceState(t, r, &locationResourceModel{ID: types.StringValue("location-1"), Type: types.StringValue("url"),
+		Target: types.StringValue("http://test1"), LastUpdated: types.StringValue("Monday, 01-Jan-24 00:00:00 UTC"),
+		AnalyzeBeforeCreate: types.BoolValue(true)})
+
+	updateResp := fwresource.UpdateResponse{State: state}
+	r.Update(context.Background(), fwresource.UpdateRequest{Plan: tfsdk.Plan(plan), State: state}, &updateResp)
+	assert.Emptyf(t, updateResp.Diagnostics, "Update should not return diagnostics")
+
+	var updated locationResourceModel
+	updateResp.State.Get(context.Background(), &updated)
+	assert.Truef(t, updated.AnalyzeBeforeCreate.ValueBool(), "Settings of the resource should be updated")
+	assert.Equalf(t, "Monday, 01-Jan-24 00:00:00 UTC", updated.LastUpdated.ValueString(), "Last updated should not change without changes of the location")
+}
+
+func TestLocationResource_TypeRequiresReplace(t *testing.T) {
+	var schemaResp fwresource.SchemaResponse
+	(&locationResource{}).Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+	req := planmodifier.StringRequest{StateValue: types.StringValue("url"), PlanValue: types.StringValue("file"), ConfigValue: types.StringValue("file"),
+		State: tfsdk.State{Raw: raw}, Plan: tfsdk.Plan{Raw: raw}}
+	resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+	for _, m := range schemaResp.Schema.Attributes["type"].(schema.StringAttribute).PlanModifiers {
+		m.PlanModifyString(context.Background(), req, resp)
+	}
+
+	assert.Truef(t, resp.RequiresReplace, "Change of the type should require replacement")
+
+	typeAttr := schemaResp.Schema.Attributes["type"].(schema.StringAttribute)
+	var defaultResp defaults.StringResponse
+	typeAttr.Default.DefaultString(context.Background(), defaults.StringRequest{}, &defaultResp)
+	assert.Equalf(t, "url", defaultResp.PlanValue.ValueString(), "Type should default to url")
+
+	req = planmodifier.StringRequest{StateValue: types.StringValue("file"), PlanValue: defaultResp.PlanValue, ConfigValue: types.StringNull(),
+		State: tfsdk.State{Raw: raw}, Plan: tfsdk.Plan{Raw: raw}}
+	resp = &planmodifier.StringResponse{PlanValue: req.PlanValue}
+	for _, m := range typeAttr.PlanModifiers {
+		m.PlanModifyString(context.Background(), req, resp)
+	}
+
+	assert.Truef(t, resp.RequiresReplace, "Removal of the type should require replacement back to the default one")
+}
+
+func TestLocationResource_PresenceRequiresReplace(t *testing.T) {
+	var schemaResp fwresource.SchemaResponse
+	(&locationResource{}).Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+	req := planmodifier.StringRequest{StateValue: types.StringValue("required"), PlanValue: types.StringValue("optional"),
+		ConfigValue: types.StringValue("optional"), State: tfsdk.State{Raw: raw}, Plan: tfsdk.Plan{Raw: raw}}
+	resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+	for _, m := range schemaResp.Schema.Attributes["presence"].(schema.StringAttribute).PlanModifiers {
+		m.PlanModifyString(context.Background(), req, resp)
+	}
+
+	assert.Truef(t, resp.RequiresReplace, "Change of the presence from the default one should require replacement")
+}
+
+func TestLocationResource_CreateWithTypeAndPresence(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body client.CreateLocationOptions
+		assert.NoErrorf(t, json.NewDecoder(r.Body).Decode(&body), "Request body should be decoded")
+		assert.Equalf(t, client.CreateLocationOptions{Type: "file", Target: "/etc/backstage/catalog-info.yaml", Presence: "optional"}, body,
+			"Type and presence should be sent to the API")
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(backstage.LocationCreateResponse{Location: &backstage.LocationResponse{ID: "location-1", Type: body.Type,
+			Target: body.Target}})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	r := &locationResource{client: c}
+
+	plan := newLocationResourceState(t, r, &locationResourceModel{ID: types.StringUnknown(), Type: types.StringValue("file"),
+		Target: types.StringValue("/etc/backstage/catalog-info.yaml"), Presence: types.StringValue("optional"), LastUpdated: types.StringUnknown()})
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: tfsdk.Plan(plan)}, &createResp)
+	assert.Emptyf(t, createResp.Diagnostics, "Create should not return diagnostics")
+
+	var created locationResourceModel
+	createResp.State.Get(context.Background(), &created)
+	assert.Equalf(t, "file", created.Type.ValueString(), "Location type should be set")
+	assert.Equalf(t, "optional", created.Presence.ValueString(), "Location presence should be kept")
+}
+
+func TestLocationResource_CreateWaitsForEntities(t *testing.T) {
+	var polls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/catalog/locations" && r.URL.Query().Get("dryRun") == "true":
+			_ = json.NewEncoder(w).Encode(backstage.LocationCreateResponse{Location: &backstage.LocationResponse{},
+				Entities: []backstage.Entity{
+					{Kind: "Location", Metadata: backstage.EntityMeta{Name: "generated-1", Namespace: "default"}},
+					{Kind: "Component", Metadata: backstage.EntityMeta{Name: "artist-web", Namespace: "default"}},
+				}})
+		case r.URL.Path == "/api/catalog/locations":
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(backstage.LocationCreateResponse{Location: &backstage.LocationResponse{ID: "location-1", Type: "url",
+				Target: "https://example.com/catalog-info.yaml"}, Entities: []backstage.Entity{}})
+		case r.URL.Path == "/api/catalog/entities/by-refs":
+			polls++
+			var body struct {
+				EntityRefs []string `json:"entityRefs"`
+			}
+			assert.NoErrorf(t, json.NewDecoder(r.Body).Decode(&body), "Request body should be decoded")
+			assert.Equalf(t, []string{"location:default/generated-1", "component:default/artist-web"}, body.EntityRefs,
+				"Entities of the dry run should be requested")
+
+			if polls < 3 {
+				_, _ = w.Write([]byte(`{"items":[{"kind":"Location","metadata":{"name":"generated-1"}},null]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"items":[{"kind":"Location","metadata":{"name":"generated-1"}},{"kind":"Component","metadata":{"name":"artist-web"}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	r := &locationResource{client: c, pollInterval: time.Millisecond}
+
+	plan := newLocationResourceState(t, r, &locationResourceModel{ID: types.StringUnknown(), Type: types.StringUnknown(),
+		Target: types.StringValue("https://example.com/catalog-info.yaml"), WaitForEntities: types.BoolValue(true),
+		LastUpdated: types.StringUnknown(), EntityRefs: types.ListUnknown(types.StringType)})
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: tfsdk.Plan(plan)}, &createResp)
+	assert.Emptyf(t, createResp.Diagnostics, "Create should not return diagnostics")
+	assert.Equalf(t, 3, polls, "Entities should be polled until all of them exist")
+
+	var created locationResourceModel
+	createResp.State.Get(context.Background(), &created)
+	var refs []string
+	created.EntityRefs.ElementsAs(context.Background(), &refs, false)
+	assert.Equalf(t, []string{"location:default/generated-1", "component:default/artist-web"}, refs, "Entity refs should be set")
+}
+
+func TestLocationResource_CreateWaitForEntitiesTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/catalog/locations" && r.URL.Query().Get("dryRun") == "true":
+			_ = json.NewEncoder(w).Encode(backstage.LocationCreateResponse{Location: &backstage.LocationResponse{},
+				Entities: []backstage.Entity{{Kind: "Component", Metadata: backstage.EntityMeta{Name: "artist-web", Namespace: "default"}}}})
+		case r.URL.Path == "/api/catalog/locations":
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(backstage.LocationCreateResponse{Location: &backstage.LocationResponse{ID: "location-1", Type: "url",
+				Target: "https://example.com/catalog-info.yaml"}})
+		case r.URL.Path == "/api/catalog/entities/by-refs":
+			_, _ = w.Write([]byte(`{"items":[null]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	r := &locationResource{client: c, pollInterval: time.Millisecond}
+
+	plan := newLocationResourceState(t, r, &locationResourceModel{ID: types.StringUnknown(), Type: types.StringUnknown(),
+		Target: types.StringValue("https://example.com/catalog-info.yaml"), WaitForEntities: types.BoolValue(true),
+		Timeouts: &locationTimeoutsModel{Create: types.StringValue("50ms")}, LastUpdated: types.StringUnknown(),
+		EntityRefs: types.ListUnknown(types.StringType)})
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: tfsdk.Plan(plan)}, &createResp)
+	assert.Truef(t, createResp.Diagnostics.HasError(), "Create should fail, when entities do not appear in time")
+	assert.Containsf(t, createResp.Diagnostics.Errors()[0].Detail(), "component:default/artist-web", "Missing entities should be listed")
+
+	var created locationResourceModel
+	createResp.State.Get(context.Background(), &created)
+	assert.Equalf(t, "location-1", created.ID.ValueString(), "Location should be kept in the state")
+}
+
+func TestLocationResource_DeleteOrphansOnDestroy(t *testing.T) {
+	var deletedUIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/catalog/locations/location-1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/catalog/entities/by-query" && r.URL.Query().Get("cursor") == "":
+			assert.Equalf(t, "metadata.annotations.backstage.io/managed-by-location=url:https://example.com/catalog-info.yaml",
+				r.URL.Query().Get("filter"), "Entities should be filtered by the location")
+			_, _ = w.Write([]byte(`{"items":[{"kind":"Component","metadata":{"name":"artist-web","namespace":"default","uid":"uid-1",` +
+				`"annotations":{"backstage.io/managed-by-location":"url:https://example.com/catalog-info.yaml"}}}],` +
+				`"totalItems":3,"pageInfo":{"nextCursor":"page-2"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/catalog/entities/by-query":
+			_, _ = w.Write([]byte(`{"items":[{"kind":"API","metadata":{"name":"artist-api","uid":"uid-2",` +
+				`"annotations":{"backstage.io/managed-by-location":"url:https://example.com/catalog-info.yaml"}}},` +
+				`{"kind":"API","metadata":{"name":"other-api","uid":"uid-3",` +
+				`"annotations":{"backstage.io/managed-by-location":"url:https://example.com/catalog-info.yaml=other"}}}],` +
+				`"totalItems":3,"pageInfo":{}}`))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/catalog/entities/by-uid/"):
+			deletedUIDs = append(deletedUIDs, strings.TrimPrefix(r.URL.Path, "/api/catalog/entities/by-uid/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	r := &locationResource{client: c}
+
+	state := newLocationResourceState(t, r, &locationResourceModel{ID: types.StringValue("location-1"), Type: types.StringValue("url"),
+		Target: types.StringValue("https://example.com/catalog-info.yaml"), DeleteOrphansOnDestroy: types.BoolValue(true),
+		LastUpdated: types.StringValue("Monday, 01-Jan-24 00:00:00 UTC")})
+	deleteResp := fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, &deleteResp)
+	assert.Falsef(t, deleteResp.Diagnostics.HasError(), "Delete should not return errors")
+	assert.Equalf(t, []string{"uid-1", "uid-2"}, deletedUIDs, "Only entities of the location should be deleted by UID")
+	assert.Equalf(t, 1, deleteResp.Diagnostics.WarningsCount(), "Deleted entities should be reported")
+	assert.Containsf(t, deleteResp.Diagnostics.Warnings()[0].Detail(), "component:default/artist-web, api:default/artist-api",
+		"Deleted entities should be listed")
+}
+
+func TestLocationResource_DeleteOrphansOnDestroyCommaTarget(t *testing.T) {
+	var entityRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/catalog/locations/location-1":
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasPrefix(r.URL.Path, "/api/catalog/entities/"):
+			entityRequests++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	r := &locationResource{client: c}
+
+	state := newLocationResourceState(t, r, &locationResourceModel{ID: types.StringValue("location-1"), Type: types.StringValue("url"),
+		Target: types.StringValue("https://example.com/catalog-info.yaml?a=1,b=2"), DeleteOrphansOnDestroy: types.BoolValue(true),
+		LastUpdated: types.StringValue("Monday, 01-Jan-24 00:00:00 UTC")})
+	deleteResp := fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, &deleteResp)
+	assert.Falsef(t, deleteResp.Diagnostics.HasError(), "Delete should not return errors")
+	assert.Equalf(t, 0, entityRequests, "Entities should be neither queried nor deleted")
+	assert.Equalf(t, 1, deleteResp.Diagnostics.WarningsCount(), "Skipped cleanup should be reported")
+	assert.Containsf(t, deleteResp.Diagnostics.Warnings()[0].Summary(), "not deleted", "Warning should explain the skipped cleanup")
+}
@@ -0,0 +1,520 @@
+package backstage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &scaffolderTaskResource{}
+	_ resource.ResourceWithConfigure   = &scaffolderTaskResource{}
+	_ resource.ResourceWithImportState = &scaffolderTaskResource{}
+)
+
+// NewScaffolderTaskResource is a helper function to simplify the provider implementation.
+func NewScaffolderTaskResource() resource.Resource {
+	return &scaffolderTaskResource{}
+}
+
+// scaffolderTaskResource is the resource implementation.
+type scaffolderTaskResource struct {
+	client *client.Client
+}
+
+// scaffolderTaskResourceModel maps the resource schema data.
+type scaffolderTaskResourceModel struct {
+	ID              types.String                 `tfsdk:"id"`
+	TemplateRef     types.String                 `tfsdk:"template_ref"`
+	Values          jsontypes.Normalized         `tfsdk:"values"`
+	PollInterval    types.String                 `tfsdk:"poll_interval"`
+	CancelOnDestroy types.Bool                   `tfsdk:"cancel_on_destroy"`
+	Timeouts        *scaffolderTaskTimeoutsModel `tfsdk:"timeouts"`
+	Status          types.String                 `tfsdk:"status"`
+	CreatedAt       types.String                 `tfsdk:"created_at"`
+	Steps           types.List                   `tfsdk:"steps"`
+	Output          types.Object                 `tfsdk:"output"`
+}
+
+type scaffolderTaskTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+}
+
+type scaffolderTaskStepModel struct {
+	ID      types.String `tfsdk:"id"`
+	Status  types.String `tfsdk:"status"`
+	Message types.String `tfsdk:"message"`
+}
+
+type scaffolderTaskOutputModel struct {
+	Links     []scaffolderTaskOutputLinkModel `tfsdk:"links"`
+	EntityRef types.String                    `tfsdk:"entity_ref"`
+	Raw       jsontypes.Normalized            `tfsdk:"raw"`
+}
+
+type scaffolderTaskOutputLinkModel struct {
+	Title     types.String `tfsdk:"title"`
+	URL       types.String `tfsdk:"url"`
+	EntityRef types.String `tfsdk:"entity_ref"`
+	Icon      types.String `tfsdk:"icon"`
+}
+
+var (
+	scaffolderTaskStepAttrTypes = map[string]attr.Type{
+		"id":      types.StringType,
+		"status":  types.StringType,
+		"message": types.StringType,
+	}
+	scaffolderTaskOutputLinkAttrTypes = map[string]attr.Type{
+		"title":      types.StringType,
+		"url":        types.StringType,
+		"entity_ref": types.StringType,
+		"icon":       types.StringType,
+	}
+	scaffolderTaskOutputAttrTypes = map[string]attr.Type{
+		"links":      types.ListType{ElemType: types.ObjectType{AttrTypes: scaffolderTaskOutputLinkAttrTypes}},
+		"entity_ref": types.StringType,
+		"raw":        jsontypes.NormalizedType{},
+	}
+)
+
+const (
+	defaultScaffolderTaskPollInterval  = 5 * time.Second
+	defaultScaffolderTaskCreateTimeout = 10 * time.Minute
+
+	descriptionScaffolderTaskID                  = "Identifier of the Scaffolder task."
+	descriptionScaffolderTaskTemplateRef         = "Reference of the template to execute, e.g. `template:default/create-react-app`."
+	descriptionScaffolderTaskValues              = "Values to execute the template with, as JSON object matching the template `parameters`."
+	descriptionScaffolderTaskPollInterval        = "Interval to poll the status of the task in, e.g. `10s` (default: `5s`)."
+	descriptionScaffolderTaskCancelOnDestroy     = "Whether to cancel the task on destroy, if it is still running (default: `false`). Otherwise destroy only removes the task from Terraform state."
+	descriptionScaffolderTaskTimeouts            = "Timeouts of the task operations."
+	descriptionScaffolderTaskTimeoutsCreate      = "Time to wait for the task to finish for, e.g. `30m` (default: `10m`)."
+	descriptionScaffolderTaskStatus              = "Status of the task: `open`, `processing`, `completed`, `failed` or `cancelled`."
+	descriptionScaffolderTaskCreatedAt           = "Timestamp of the creation of the task."
+	descriptionScaffolderTaskSteps               = "Summary of the step logs of the task, in the order the steps were executed."
+	descriptionScaffolderTaskStepID              = "ID of the template step."
+	descriptionScaffolderTaskStepStatus          = "Last reported status of the step, e.g. `processing`, `completed`, `failed` or `skipped`."
+	descriptionScaffolderTaskStepMessage         = "Last log message of the step."
+	descriptionScaffolderTaskOutput              = "Output of the finished task, as described by the `output` of the template."
+	descriptionScaffolderTaskOutputLinks         = "Links to the resources created by the task, e.g. the repository or the catalog entity."
+	descriptionScaffolderTaskOutputLinkTitle     = "A user-friendly display name for the link."
+	descriptionScaffolderTaskOutputLinkURL       = "URL of the link."
+	descriptionScaffolderTaskOutputLinkEntityRef = "Reference of the catalog entity the link points to."
+	descriptionScaffolderTaskOutputLinkIcon      = "A key representing a visual icon to be displayed in the UI."
+	descriptionScaffolderTaskOutputEntityRef     = "Reference of the catalog entity created by the task, if any."
+	descriptionScaffolderTaskOutputRaw           = "Complete output of the task, including custom values (as JSON)."
+)
+
+// Metadata returns the data source type name.
+func (r *scaffolderTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scaffolder_task"
+}
+
+// Schema defines the schema for the resource.
+func (r *scaffolderTaskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to execute Backstage [Software Templates](https://backstage.io/docs/features/software-templates/) " +
+			"by running Scaffolder tasks. The task is created once and Terraform waits for it to finish. Changing `template_ref` or `values` " +
+			"runs a new task. Tasks purged by Backstage after they finished are kept in the state with their last known status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskID, PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			}},
+			"template_ref": schema.StringAttribute{Required: true, MarkdownDescription: descriptionScaffolderTaskTemplateRef,
+				Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
+			"values": schema.StringAttribute{Optional: true, CustomType: jsontypes.NormalizedType{}, MarkdownDescription: descriptionScaffolderTaskValues,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()}},
+			"poll_interval": schema.StringAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionScaffolderTaskPollInterval,
+				Default: stringdefault.StaticString(defaultScaffolderTaskPollInterval.String()), Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(patternDuration), "must be a valid duration, e.g. 500ms, 10s or 1m"),
+				}},
+			"cancel_on_destroy": schema.BoolAttribute{Optional: true, Computed: true, MarkdownDescription: descriptionScaffolderTaskCancelOnDestroy,
+				Default: booldefault.StaticBool(false)},
+			"timeouts": schema.SingleNestedAttribute{Optional: true, Description: descriptionScaffolderTaskTimeouts, Attributes: map[string]schema.Attribute{
+				"create": schema.StringAttribute{Optional: true, MarkdownDescription: descriptionScaffolderTaskTimeoutsCreate, Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(patternDuration), "must be a valid duration, e.g. 500ms, 10s or 1m"),
+				}},
+			}},
+			"status": schema.StringAttribute{Computed: true, MarkdownDescription: descriptionScaffolderTaskStatus, PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			}},
+			"created_at": schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskCreatedAt, PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			}},
+			"steps": schema.ListNestedAttribute{Computed: true, Description: descriptionScaffolderTaskSteps, PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			}, NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id":      schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskStepID},
+					"status":  schema.StringAttribute{Computed: true, MarkdownDescription: descriptionScaffolderTaskStepStatus},
+					"message": schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskStepMessage},
+				},
+			}},
+			"output": schema.SingleNestedAttribute{Computed: true, MarkdownDescription: descriptionScaffolderTaskOutput, PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.UseStateForUnknown(),
+			}, Attributes: map[string]schema.Attribute{
+				"links": schema.ListNestedAttribute{Computed: true, Description: descriptionScaffolderTaskOutputLinks, NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title":      schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskOutputLinkTitle},
+						"url":        schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskOutputLinkURL},
+						"entity_ref": schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskOutputLinkEntityRef},
+						"icon":       schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskOutputLinkIcon},
+					},
+				}},
+				"entity_ref": schema.StringAttribute{Computed: true, Description: descriptionScaffolderTaskOutputEntityRef},
+				"raw":        schema.StringAttribute{Computed: true, CustomType: jsontypes.NormalizedType{}, Description: descriptionScaffolderTaskOutputRaw},
+			}},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *scaffolderTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*client.Client)
+}
+
+// Create starts a new Scaffolder task, waits for it to finish and sets the initial Terraform state.
+func (r *scaffolderTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scaffolderTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pollInterval, err := time.ParseDuration(plan.PollInterval.ValueString())
+	if err != nil || pollInterval <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid poll interval",
+			fmt.Sprintf("Could not parse poll interval %q, it must be a positive duration.", plan.PollInterval.ValueString()))
+		return
+	}
+
+	createTimeout := defaultScaffolderTaskCreateTimeout
+	if plan.Timeouts != nil && !plan.Timeouts.Create.IsNull() {
+		if createTimeout, err = time.ParseDuration(plan.Timeouts.Create.ValueString()); err != nil || createTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("timeouts").AtName("create"), "Invalid create timeout",
+				fmt.Sprintf("Could not parse create timeout %q, it must be a positive duration.", plan.Timeouts.Create.ValueString()))
+			return
+		}
+	}
+
+	var values json.RawMessage
+	if !plan.Values.IsNull() {
+		values = json.RawMessage(plan.Values.ValueString())
+	}
+
+	id, response, err := r.client.CreateScaffolderTask(ctx, plan.TemplateRef.ValueString(), values)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Backstage scaffolder task",
+			fmt.Sprintf("Could not create scaffolder task of template %s, unexpected error: %s", plan.TemplateRef.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Error creating Backstage scaffolder task",
+			fmt.Sprintf("Could not create scaffolder task of template %s, unexpected status code: %d", plan.TemplateRef.ValueString(),
+				response.StatusCode),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	plan.Status = types.StringValue(string(client.ScaffolderTaskStatusOpen))
+	plan.CreatedAt = types.StringNull()
+	plan.Steps = types.ListNull(types.ObjectType{AttrTypes: scaffolderTaskStepAttrTypes})
+	plan.Output = types.ObjectNull(scaffolderTaskOutputAttrTypes)
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	waitErr := r.waitForTask(waitCtx, id, pollInterval)
+	found, message := r.refresh(ctx, &plan, &resp.Diagnostics)
+
+	// The task exists regardless of its outcome, so it is kept in the state, where a failure taints it.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case waitErr != nil:
+		resp.Diagnostics.AddError("Error waiting for Backstage scaffolder task",
+			fmt.Sprintf("Could not wait for scaffolder task %s to finish (last status: %s): %s", id, plan.Status.ValueString(), waitErr.Error()),
+		)
+	case !found:
+		resp.Diagnostics.AddError("Error reading Backstage scaffolder task", fmt.Sprintf("Scaffolder task %s was not found after its creation", id))
+	case plan.Status.ValueString() != string(client.ScaffolderTaskStatusCompleted):
+		resp.Diagnostics.AddError("Backstage scaffolder task did not complete",
+			fmt.Sprintf("Scaffolder task %s of template %s finished with status %s: %s", id, plan.TemplateRef.ValueString(),
+				plan.Status.ValueString(), message),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest status, steps and output of the task, if it still exists.
+func (r *scaffolderTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scaffolderTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tasks purged by Backstage are kept in the state, as removing them would run the template again on the next apply.
+	if found, _ := r.refresh(ctx, &state, &resp.Diagnostics); !found {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddWarning("Backstage scaffolder task not found",
+				fmt.Sprintf("Scaffolder task %s was not found, e.g. because Backstage purged it, so its last known state is kept. Taint or replace "+
+					"the resource to run the template again.", state.ID.ValueString()),
+			)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the settings, which do not require a new task, and keeps the rest of the Terraform state.
+func (r *scaffolderTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scaffolderTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state scaffolderTaskResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.PollInterval = plan.PollInterval
+	state.CancelOnDestroy = plan.CancelOnDestroy
+	state.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete cancels the task, if requested and still running, and removes the Terraform state on success.
+func (r *scaffolderTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scaffolderTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.CancelOnDestroy.ValueBool() {
+		return
+	}
+
+	task, response, err := r.client.GetScaffolderTask(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Backstage scaffolder task",
+			fmt.Sprintf("Could not read scaffolder task ID %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if response.StatusCode == http.StatusNotFound || (response.StatusCode == http.StatusOK && task.Status.Finished()) {
+		return
+	}
+
+	response, err = r.client.CancelScaffolderTask(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error cancelling Backstage scaffolder task",
+			fmt.Sprintf("Could not cancel scaffolder task ID %s, unexpected error: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		resp.Diagnostics.AddError("Error cancelling Backstage scaffolder task",
+			fmt.Sprintf("Could not cancel scaffolder task ID %s, unexpected status code: %d", state.ID.ValueString(), response.StatusCode),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *scaffolderTaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForTask polls the task in the given interval, until it finishes or the context is done.
+func (r *scaffolderTaskResource) waitForTask(ctx context.Context, id string, interval time.Duration) error {
+	for {
+		task, response, err := r.client.GetScaffolderTask(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+		}
+
+		if task.Status.Finished() {
+			return nil
+		}
+
+		tflog.Debug(ctx, "Waiting for Backstage scaffolder task to finish", map[string]interface{}{
+			"backstage_scaffolder_task_id":     id,
+			"backstage_scaffolder_task_status": string(task.Status),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// refresh updates the model with the current status, steps and output of the task. It returns false, if the task does not exist, and the
+// message of the last event of the task, which explains a failure.
+func (r *scaffolderTaskResource) refresh(ctx context.Context, model *scaffolderTaskResourceModel, diags *diag.Diagnostics) (bool, string) {
+	task, response, err := r.client.GetScaffolderTask(ctx, model.ID.ValueString())
+	if err != nil {
+		diags.AddError("Error reading Backstage scaffolder task",
+			fmt.Sprintf("Could not read scaffolder task ID %s: %s", model.ID.ValueString(), err.Error()),
+		)
+		return false, ""
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return false, ""
+	}
+
+	if response.StatusCode != http.StatusOK {
+		diags.AddError("Error reading Backstage scaffolder task",
+			fmt.Sprintf("Could not read scaffolder task ID %s, unexpected status code: %d", model.ID.ValueString(), response.StatusCode),
+		)
+		return false, ""
+	}
+
+	events, response, err := r.client.ListScaffolderTaskEvents(ctx, model.ID.ValueString(), 0)
+	if err != nil {
+		diags.AddError("Error reading Backstage scaffolder task events",
+			fmt.Sprintf("Could not read events of scaffolder task ID %s: %s", model.ID.ValueString(), err.Error()),
+		)
+		return false, ""
+	}
+
+	if response.StatusCode != http.StatusOK {
+		diags.AddError("Error reading Backstage scaffolder task events",
+			fmt.Sprintf("Could not read events of scaffolder task ID %s, unexpected status code: %d", model.ID.ValueString(), response.StatusCode),
+		)
+		return false, ""
+	}
+
+	var (
+		message string
+		steps   []scaffolderTaskStepModel
+		output  *client.ScaffolderTaskOutput
+		indexes = map[string]int{}
+	)
+	for _, e := range events {
+		if e.Body.Message != "" {
+			message = e.Body.Message
+		}
+
+		if e.Body.Output != nil {
+			output = e.Body.Output
+		}
+
+		if e.Body.StepID == "" {
+			continue
+		}
+
+		i, ok := indexes[e.Body.StepID]
+		if !ok {
+			i = len(steps)
+			indexes[e.Body.StepID] = i
+			steps = append(steps, scaffolderTaskStepModel{ID: types.StringValue(e.Body.StepID), Status: types.StringNull(),
+				Message: types.StringNull()})
+		}
+
+		if e.Body.Status != "" {
+			steps[i].Status = types.StringValue(e.Body.Status)
+		}
+		if e.Body.Message != "" {
+			steps[i].Message = types.StringValue(e.Body.Message)
+		}
+	}
+
+	model.Status = types.StringValue(string(task.Status))
+	model.CreatedAt = types.StringValue(task.CreatedAt)
+
+	if steps == nil {
+		steps = []scaffolderTaskStepModel{}
+	}
+	stepsValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: scaffolderTaskStepAttrTypes}, steps)
+	diags.Append(d...)
+	model.Steps = stepsValue
+
+	model.Output = types.ObjectNull(scaffolderTaskOutputAttrTypes)
+	if output != nil {
+		outputModel := scaffolderTaskOutputModel{
+			Links:     []scaffolderTaskOutputLinkModel{},
+			EntityRef: types.StringNull(),
+			Raw:       jsontypes.NewNormalizedValue(string(output.Raw)),
+		}
+
+		if ref := output.EntityRef(); ref != "" {
+			outputModel.EntityRef = types.StringValue(ref)
+		}
+
+		for _, l := range output.Links {
+			outputModel.Links = append(outputModel.Links, scaffolderTaskOutputLinkModel{
+				Title:     types.StringValue(l.Title),
+				URL:       types.StringValue(l.URL),
+				EntityRef: types.StringValue(l.EntityRef),
+				Icon:      types.StringValue(l.Icon),
+			})
+		}
+
+		outputValue, d := types.ObjectValueFrom(ctx, scaffolderTaskOutputAttrTypes, outputModel)
+		diags.Append(d...)
+		model.Output = outputValue
+	}
+
+	return !diags.HasError(), message
+}
@@ -0,0 +1,117 @@
+package backstage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// newScaffolderStubServer returns a server stubbing the Scaffolder API, which runs a single task to completion after a few polls.
+func newScaffolderStubServer(t *testing.T) *httptest.Server {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/scaffolder/v2/tasks":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEqf(t, `{"templateRef":"template:default/react-ssr-template","values":{"name":"test"}}`, string(body), "Request body should match")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"task-1"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/scaffolder/v2/tasks/task-1":
+			status := "completed"
+			if polls.Add(1) < 3 {
+				status = "processing"
+			}
+			_, _ = fmt.Fprintf(w, `{"id":"task-1","status":%q,"createdAt":"2024-01-01T00:00:00Z"}`, status)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/scaffolder/v2/tasks/task-1/events":
+			_, _ = w.Write([]byte(`[` +
+				`{"id":1,"taskId":"task-1","type":"log","body":{"message":"Beginning step Fetch","stepId":"fetch","status":"processing"}},` +
+				`{"id":2,"taskId":"task-1","type":"log","body":{"message":"Finished step Fetch","stepId":"fetch","status":"completed"}},` +
+				`{"id":3,"taskId":"task-1","type":"log","body":{"message":"Finished step Register","stepId":"register","status":"completed"}},` +
+				`{"id":4,"taskId":"task-1","type":"completion","body":{"message":"Run completed","status":"completed","output":` +
+				`{"links":[{"title":"Open in catalog","icon":"catalog","entityRef":"component:default/test"}]}}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAccResourceScaffolderTask(t *testing.T) {
+	server := newScaffolderStubServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccResourceScaffolderTaskConfig, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("backstage_scaffolder_task.test", "id", "task-1"),
+					resource.TestCheckResourceAttr("backstage_scaffolder_task.test", "status", "completed"),
+					resource.TestCheckResourceAttr("backstage_scaffolder_task.test", "steps.#", "2"),
+					resource.TestCheckResourceAttr("backstage_scaffolder_task.test", "steps.0.id", "fetch"),
+					resource.TestCheckResourceAttr("backstage_scaffolder_task.test", "steps.0.status", "completed"),
+					resource.TestCheckResourceAttr("backstage_scaffolder_task.test", "steps.0.message", "Finished step Fetch"),
+					resource.TestCheckResourceAttr("backstage_scaffolder_task.test", "output.entity_ref", "component:default/test"),
+					resource.TestCheckResourceAttr("backstage_scaffolder_task.test", "output.links.0.title", "Open in catalog"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceScaffolderTaskConfig = `
+provider "backstage" {
+  base_url = "%s"
+}
+
+resource "backstage_scaffolder_task" "test" {
+  template_ref  = "template:default/react-ssr-template"
+  values        = jsonencode({ name = "test" })
+  poll_interval = "10ms"
+}
+`
+
+func TestScaffolderTaskResource_ReadPurgedTask(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	r := &scaffolderTaskResource{client: c}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}
+	assert.Emptyf(t, state.Set(context.Background(), &scaffolderTaskResourceModel{ID: types.StringValue("task-1"),
+		TemplateRef: types.StringValue("template:default/react-ssr-template"), Values: jsontypes.NewNormalizedNull(),
+		PollInterval: types.StringValue("5s"), CancelOnDestroy: types.BoolValue(false), Status: types.StringValue("completed"),
+		CreatedAt: types.StringValue("2024-01-01T00:00:00Z"), Steps: types.ListNull(types.ObjectType{AttrTypes: scaffolderTaskStepAttrTypes}),
+		Output: types.ObjectNull(scaffolderTaskOutputAttrTypes)}), "State should be set")
+
+	readResp := fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, &readResp)
+	assert.Falsef(t, readResp.Diagnostics.HasError(), "Read should not return errors")
+	assert.Equalf(t, 1, readResp.Diagnostics.WarningsCount(), "Purged task should be reported")
+	assert.Falsef(t, readResp.State.Raw.IsNull(), "Purged task should be kept in the state")
+
+	var read scaffolderTaskResourceModel
+	readResp.State.Get(context.Background(), &read)
+	assert.Equalf(t, "completed", read.Status.ValueString(), "Last known status should be kept")
+}
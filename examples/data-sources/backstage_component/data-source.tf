@@ -5,3 +5,9 @@ data "backstage_component" "example" {
   # If not provided, namespace defaults to "default" or the the one set in the provider:
   namespace = "example-namespace"
 }
+
+# Fails, if the catalog reports errors in processing of the component:
+data "backstage_component" "example_strict" {
+  name                      = "example-component"
+  fail_on_processing_errors = true
+}
@@ -11,3 +11,22 @@ data "backstage_entities" "example" {
 output "example" {
   value = jsondecode(data.backstage_entities.example.entities[0].spec)["profile"]["email"]
 }
+
+# Retrieves only selected fields of the first 100 components, ordered by their owner:
+data "backstage_entities" "example_components" {
+  filters = ["kind=Component"]
+  // Fields not included are returned empty:
+  fields = ["metadata.name", "spec.owner"]
+  order = [
+    { field = "spec.owner" },
+    { field = "metadata.name", direction = "desc" },
+  ]
+  limit = 100
+}
+
+# Retrieves components, which description mentions "payments":
+data "backstage_entities" "example_payments" {
+  filters          = ["kind=Component"]
+  full_text_term   = "payments"
+  full_text_fields = ["metadata.description"]
+}
@@ -0,0 +1,14 @@
+# Retrieves multiple entities by their references with a single request:
+data "backstage_entities_by_refs" "example" {
+  refs = [
+    "component:default/example-component",
+    "api:example-namespace/example-api",
+  ]
+  // Missing entities are returned as `null`, unless set to true:
+  fail_on_missing = false
+}
+
+# Outputs owners of the found entities:
+output "example_owners" {
+  value = [for e in data.backstage_entities_by_refs.example.entities : jsondecode(e.spec).owner if e != null]
+}
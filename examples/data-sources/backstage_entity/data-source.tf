@@ -0,0 +1,18 @@
+# Retrieves specific entity of any kind, including custom ones:
+data "backstage_entity" "example" {
+  # Required kind and name of the entity, unless `ref` is set:
+  kind = "Template"
+  name = "example-template"
+  # If not provided, namespace defaults to "default" or the one set in the provider:
+  namespace = "example-namespace"
+}
+
+# Retrieves specific entity by its reference:
+data "backstage_entity" "example_ref" {
+  ref = "database:example-namespace/example-database"
+}
+
+# Spec of the entity is available as JSON:
+output "example_owner" {
+  value = jsondecode(data.backstage_entity.example_ref.spec).owner
+}
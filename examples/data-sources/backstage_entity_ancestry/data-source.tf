@@ -0,0 +1,15 @@
+# Retrieves ancestry of a specific component:
+data "backstage_entity_ancestry" "example" {
+  kind = "Component"
+  name = "example-component"
+  # If not provided, namespace defaults to "default":
+  namespace = "example-namespace"
+}
+
+# Outputs targets of the root locations, which emitted the component:
+output "example_root_locations" {
+  value = [
+    for a in data.backstage_entity_ancestry.example.ancestors : jsondecode(a.entity.spec).target
+    if contains(data.backstage_entity_ancestry.example.origin_refs, a.ref) && a.entity.kind == "Location"
+  ]
+}
@@ -0,0 +1,11 @@
+# Retrieves distinct values of component types and tags:
+data "backstage_entity_facets" "example" {
+  facets = ["spec.type", "metadata.tags"]
+  // The filters to apply to the entities:
+  filters = ["kind=Component"]
+}
+
+# Creates a set of component types, e.g. for use in `for_each`:
+output "example_types" {
+  value = toset([for v in data.backstage_entity_facets.example.values["spec.type"] : v.value])
+}
@@ -0,0 +1,24 @@
+# Validates generated entity descriptor, before it is committed to the repository:
+data "backstage_entity_validation" "example" {
+  entity = jsonencode({
+    apiVersion = "backstage.io/v1alpha1"
+    kind       = "Component"
+    metadata = {
+      name = "example-component"
+    }
+    spec = {
+      type      = "service"
+      lifecycle = "production"
+      owner     = "group:default/example-team"
+    }
+  })
+  # Reference of the location the entity is going to be read from:
+  location = "url:https://github.com/example/repo/blob/main/catalog-info.yaml"
+
+  lifecycle {
+    postcondition {
+      condition     = self.valid
+      error_message = join("\n", [for e in self.errors : e.message])
+    }
+  }
+}
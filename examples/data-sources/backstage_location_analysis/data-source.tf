@@ -0,0 +1,11 @@
+# Analyzes repository, before registering it as a location:
+data "backstage_location_analysis" "example" {
+  target = "https://github.com/example/repo/blob/main/catalog-info.yaml"
+  # If not provided, type defaults to "url":
+  type = "url"
+}
+
+# References of the entities defined in the existing catalog files:
+output "example_existing_refs" {
+  value = data.backstage_location_analysis.example.existing_entities[*].ref
+}
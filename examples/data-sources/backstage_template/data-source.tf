@@ -0,0 +1,12 @@
+# Retrieves specific Scaffolder template data:
+data "backstage_template" "example" {
+  # Required name of the template:
+  name = "example-template"
+  # If not provided, namespace defaults to "default" or the the one set in the provider:
+  namespace = "example-namespace"
+}
+
+# Parameters of the template are available as JSON:
+output "example_parameters" {
+  value = jsondecode(data.backstage_template.example.spec.parameters)
+}
@@ -8,4 +8,8 @@ provider "backstage" {
   headers = {
     "Custom-Header" = "header_value"
   }
+  # Authenticate using backend tokens minted from the shared secret of the Backstage instance:
+  auth = {
+    legacy_secret = "c2VjcmV0LWtleS11c2VkLWZvci1iYWNrZW5kLXRva2Vucw=="
+  }
 }
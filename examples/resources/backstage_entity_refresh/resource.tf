@@ -0,0 +1,8 @@
+# Refreshes the entity, whenever the content of its catalog file changes:
+resource "backstage_entity_refresh" "example" {
+  entity_ref = "component:default/artist-web"
+
+  triggers = {
+    catalog_file = filesha256("${path.module}/catalog-info.yaml")
+  }
+}
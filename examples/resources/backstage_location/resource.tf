@@ -3,3 +3,37 @@ resource "backstage_location" "example" {
   # URL to the location target:
   target = "http://example-target"
 }
+
+# Registers the location only, if the target yields any entities:
+resource "backstage_location" "example_analyzed" {
+  target                = "https://github.com/example/repo/blob/main/catalog-info.yaml"
+  analyze_before_create = true
+}
+
+# Registers optional catalog file on the Backstage host, which may not exist yet:
+resource "backstage_location" "example_file" {
+  type     = "file"
+  target   = "/etc/backstage/catalog-info.yaml"
+  presence = "optional"
+}
+
+# Waits until the entities of the location are ingested into the catalog, without processing errors:
+resource "backstage_location" "example_waited" {
+  target                    = "https://github.com/example/repo/blob/main/catalog-info.yaml"
+  wait_for_entities         = true
+  fail_on_processing_errors = true
+
+  timeouts = {
+    create = "10m"
+  }
+}
+
+output "example_waited_entity_refs" {
+  value = backstage_location.example_waited.entity_refs
+}
+
+# Deletes the entities of the location from the catalog along with the location, instead of leaving them as orphans:
+resource "backstage_location" "example_cleaned_up" {
+  target                    = "https://github.com/example/repo/blob/main/catalog-info.yaml"
+  delete_orphans_on_destroy = true
+}
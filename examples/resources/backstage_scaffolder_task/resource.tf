@@ -0,0 +1,20 @@
+# Executes the template and waits for the task to finish.
+resource "backstage_scaffolder_task" "example" {
+  # Reference to the template to execute:
+  template_ref = "template:default/example-template"
+  # Values matching the parameters of the template:
+  values = jsonencode({
+    name  = "example-component"
+    owner = "group:default/example-team"
+  })
+  # If not provided, polls every 5 seconds for up to 10 minutes:
+  poll_interval = "10s"
+  timeouts = {
+    create = "30m"
+  }
+}
+
+# Catalog entity registered by the task:
+output "example_entity_ref" {
+  value = backstage_scaffolder_task.example.output.entity_ref
+}
@@ -0,0 +1,98 @@
+// Package client extends the go-backstage client with Backstage API endpoints it does not cover yet.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/datolabs-io/go-backstage/v3"
+)
+
+const contentTypeJSON = "application/json"
+
+// Client manages communication with the Backstage API. It embeds the go-backstage client, so that the services it provides are available
+// as well.
+type Client struct {
+	*backstage.Client
+
+	// client is an HTTP client used to communicate with the API.
+	client *http.Client
+}
+
+// NewClient returns a new Backstage API client. If a nil httpClient is provided, a new http.Client will be used.
+func NewClient(baseURL string, defaultNamespace string, httpClient *http.Client) (*Client, error) {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	c, err := backstage.NewClient(baseURL, defaultNamespace, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{Client: c, client: httpClient}, nil
+}
+
+// newRequest creates an API request. A relative URL can be provided in urlStr, in which case it is resolved relative to the BaseURL.
+func (c *Client) newRequest(ctx context.Context, method string, urlStr string, body interface{}) (*http.Request, error) {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Path, _ = url.JoinPath(c.BaseURL.Path, u.Path)
+	resolvedURL := c.BaseURL.ResolveReference(u).String()
+
+	var buf io.ReadWriter
+	if body != nil {
+		buf = &bytes.Buffer{}
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, resolvedURL, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", contentTypeJSON)
+	}
+
+	req.Header.Set("Accept", contentTypeJSON)
+
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	return req, nil
+}
+
+// do sends an API request and returns the API response. Successful responses are JSON decoded and stored in the value pointed to by v.
+// Unsuccessful responses are returned without an error, so that the caller can act on their status code.
+func (c *Client) do(req *http.Request, v interface{}) (*http.Response, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	if v == nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp, nil
+	}
+
+	decErr := json.NewDecoder(resp.Body).Decode(v)
+	if decErr == io.EOF {
+		decErr = nil
+	}
+
+	return resp, decErr
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a client for a test server serving the handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL, "", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+
+	return c
+}
+
+func TestNewClient(t *testing.T) {
+	c, err := NewClient("https://backstage.test/", "custom", nil)
+	assert.NoErrorf(t, err, "Client should be created")
+	assert.Equalf(t, "https://backstage.test/api", c.BaseURL.String(), "Base URL should include API path")
+	assert.Equalf(t, "custom", c.DefaultNamespace, "Default namespace should be set")
+	assert.NotNilf(t, c.Catalog, "Catalog service should be available")
+}
+
+func TestClient_do(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, contentTypeJSON, r.Header.Get("Content-Type"), "Request should have JSON content type")
+		assert.Equalf(t, contentTypeJSON, r.Header.Get("Accept"), "Request should accept JSON")
+
+		if r.URL.Path == "/api/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"name":"NotFoundError"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"value":"test"}`))
+	})
+
+	var v struct {
+		Value string `json:"value"`
+	}
+
+	req, err := c.newRequest(context.Background(), http.MethodPost, "/found", map[string]string{"key": "value"})
+	assert.NoErrorf(t, err, "Request should be created")
+	resp, err := c.do(req, &v)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be returned")
+	assert.Equalf(t, "test", v.Value, "Successful response should be decoded")
+
+	v.Value = ""
+	req, _ = c.newRequest(context.Background(), http.MethodPost, "/missing", map[string]string{"key": "value"})
+	resp, err = c.do(req, &v)
+	assert.NoErrorf(t, err, "Unsuccessful response should not return an error")
+	assert.Equalf(t, http.StatusNotFound, resp.StatusCode, "Response status should be returned")
+	assert.Emptyf(t, v.Value, "Unsuccessful response should not be decoded")
+}
@@ -0,0 +1,369 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
+)
+
+const entitiesApiPath = "/catalog/entities"
+
+// EntityRef identifies an entity by its kind, namespace and name.
+type EntityRef struct {
+	// Kind of the entity, e.g. "Component".
+	Kind string
+
+	// Namespace the entity belongs to.
+	Namespace string
+
+	// Name of the entity.
+	Name string
+}
+
+// ParseEntityRef parses an entity reference in the [<kind>:][<namespace>/]<name> format. Kind and namespace default to the provided values,
+// if they are missing in the reference.
+func ParseEntityRef(ref string, defaultKind string, defaultNamespace string) (EntityRef, error) {
+	r := EntityRef{Kind: defaultKind, Namespace: defaultNamespace, Name: ref}
+
+	if kind, rest, ok := strings.Cut(r.Name, ":"); ok {
+		r.Kind, r.Name = kind, rest
+	}
+
+	if namespace, rest, ok := strings.Cut(r.Name, "/"); ok {
+		r.Namespace, r.Name = namespace, rest
+	}
+
+	if r.Kind == "" || r.Namespace == "" || r.Name == "" || strings.ContainsAny(r.Name, ":/") {
+		return EntityRef{}, fmt.Errorf("invalid entity reference %q: must be in the [<kind>:][<namespace>/]<name> format", ref)
+	}
+
+	return r, nil
+}
+
+// String returns the entity reference in the <kind>:<namespace>/<name> format, with the kind in lower case.
+func (r EntityRef) String() string {
+	return fmt.Sprintf("%s:%s/%s", strings.ToLower(r.Kind), r.Namespace, r.Name)
+}
+
+// GetEntityByName returns an entity of any kind, identified by its reference. The namespace defaults to the default one of the client.
+func (c *Client) GetEntityByName(ctx context.Context, ref EntityRef) (*backstage.Entity, *http.Response, error) {
+	if ref.Namespace == "" {
+		ref.Namespace = c.DefaultNamespace
+	}
+
+	path, _ := url.JoinPath(entitiesApiPath, "/by-name/", strings.ToLower(ref.Kind), ref.Namespace, ref.Name)
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entity *backstage.Entity
+	resp, err := c.do(req, &entity)
+
+	return entity, resp, err
+}
+
+// QueryEntitiesOptions specifies the parameters to the Client.QueryEntities method.
+type QueryEntitiesOptions struct {
+	// Filters is a set of conditions that can be used to filter entities.
+	Filters []string
+
+	// Fields is a set of fields that can be used to limit the response.
+	Fields []string
+
+	// Order is a set of conditions that can be used to order entities.
+	Order []backstage.ListEntityOrder
+
+	// FullTextTerm is a term to search the entities for.
+	FullTextTerm string
+
+	// FullTextFields is a set of fields to search the term in. Backstage searches its default fields, if empty.
+	FullTextFields []string
+
+	// Limit is the maximal number of entities in a single page.
+	Limit int
+
+	// Cursor points to the page to return. If set, filters, full text search and order are taken from the cursor and the ones in the options
+	// are ignored.
+	Cursor string
+}
+
+// QueryEntitiesResponse is a single page of entities returned by the Client.QueryEntities method.
+type QueryEntitiesResponse struct {
+	// Items are the entities in the page.
+	Items []backstage.Entity `json:"items"`
+
+	// TotalItems is the number of entities matching the query in all the pages.
+	TotalItems int `json:"totalItems"`
+
+	// PageInfo holds the cursors of the neighbouring pages.
+	PageInfo QueryEntitiesPageInfo `json:"pageInfo"`
+}
+
+// QueryEntitiesPageInfo holds the cursors of the pages neighbouring the returned one.
+type QueryEntitiesPageInfo struct {
+	// NextCursor points to the next page. It is empty for the last page.
+	NextCursor string `json:"nextCursor,omitempty"`
+
+	// PrevCursor points to the previous page. It is empty for the first page.
+	PrevCursor string `json:"prevCursor,omitempty"`
+}
+
+// QueryEntities returns a single page of entities matching the query. The next page is returned, when the cursor from the previous one is set
+// in the options.
+func (c *Client) QueryEntities(ctx context.Context, options *QueryEntitiesOptions) (*QueryEntitiesResponse, *http.Response, error) {
+	values := url.Values{}
+	if options != nil {
+		if options.Cursor != "" {
+			values.Set("cursor", options.Cursor)
+		} else {
+			for _, f := range options.Filters {
+				values.Add("filter", f)
+			}
+
+			for _, o := range options.Order {
+				if o.Direction != backstage.OrderAscending && o.Direction != backstage.OrderDescending {
+					return nil, nil, fmt.Errorf("invalid order direction: %s", o.Direction)
+				}
+				values.Add("orderField", fmt.Sprintf("%s,%s", o.Field, o.Direction))
+			}
+
+			if options.FullTextTerm != "" {
+				values.Set("fullTextFilterTerm", options.FullTextTerm)
+
+				if len(options.FullTextFields) > 0 {
+					values.Set("fullTextFilterFields", strings.Join(options.FullTextFields, ","))
+				}
+			}
+		}
+
+		if len(options.Fields) > 0 {
+			values.Set("fields", strings.Join(options.Fields, ","))
+		}
+
+		if options.Limit > 0 {
+			values.Set("limit", strconv.Itoa(options.Limit))
+		}
+	}
+
+	path, _ := url.JoinPath(entitiesApiPath, "/by-query")
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s?%s", path, values.Encode()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var page *QueryEntitiesResponse
+	resp, err := c.do(req, &page)
+
+	return page, resp, err
+}
+
+// EntityFacetsOptions specifies the parameters to the Client.GetEntityFacets method.
+type EntityFacetsOptions struct {
+	// Facets is a set of fields to return the distinct values of, e.g. "spec.type".
+	Facets []string
+
+	// Filters is a set of conditions that can be used to filter entities.
+	Filters []string
+}
+
+// EntityFacetsResponse holds the distinct values of the requested facets.
+type EntityFacetsResponse struct {
+	// Facets maps each requested facet to its distinct values.
+	Facets map[string][]EntityFacetValue `json:"facets"`
+}
+
+// EntityFacetValue is a distinct value of a facet, along with the number of entities having it.
+type EntityFacetValue struct {
+	// Value of the facet.
+	Value string `json:"value"`
+
+	// Count is the number of entities having the value.
+	Count int `json:"count"`
+}
+
+// GetEntityFacets returns the distinct values of the facets among the entities matching the filters.
+func (c *Client) GetEntityFacets(ctx context.Context, options *EntityFacetsOptions) (*EntityFacetsResponse, *http.Response, error) {
+	const entityFacetsApiPath = "/catalog/entity-facets"
+
+	values := url.Values{}
+	if options != nil {
+		for _, f := range options.Facets {
+			values.Add("facet", f)
+		}
+
+		for _, f := range options.Filters {
+			values.Add("filter", f)
+		}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("%s?%s", entityFacetsApiPath, values.Encode()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var facets *EntityFacetsResponse
+	resp, err := c.do(req, &facets)
+
+	return facets, resp, err
+}
+
+// EntitiesByRefsResponse holds the entities returned by the Client.GetEntitiesByRefs method.
+type EntitiesByRefsResponse struct {
+	// Items are the entities in the order of the requested references, with nil in place of the ones not found.
+	Items []*backstage.Entity `json:"items"`
+}
+
+// entitiesByRefsRequest is the body of the request sent by the Client.GetEntitiesByRefs method.
+type entitiesByRefsRequest struct {
+	EntityRefs []string `json:"entityRefs"`
+	Fields     []string `json:"fields,omitempty"`
+}
+
+// GetEntitiesByRefs returns the entities identified by the references in a single request. Fields can optionally limit the response.
+func (c *Client) GetEntitiesByRefs(ctx context.Context, refs []string, fields []string) (*EntitiesByRefsResponse, *http.Response, error) {
+	if refs == nil {
+		refs = []string{}
+	}
+
+	path, _ := url.JoinPath(entitiesApiPath, "/by-refs")
+	req, err := c.newRequest(transport.WithReadOnly(ctx), http.MethodPost, path, &entitiesByRefsRequest{EntityRefs: refs, Fields: fields})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entities *EntitiesByRefsResponse
+	resp, err := c.do(req, &entities)
+
+	return entities, resp, err
+}
+
+// EntityAncestryResponse holds the ancestry of an entity returned by the Client.GetEntityAncestry method.
+type EntityAncestryResponse struct {
+	// RootEntityRef is the reference of the entity the ancestry was requested for.
+	RootEntityRef string `json:"rootEntityRef"`
+
+	// Items are the entity itself and all its ancestors, e.g. the locations that emitted it.
+	Items []EntityAncestryItem `json:"items"`
+}
+
+// EntityAncestryItem is an entity in the ancestry, along with the references of its parents.
+type EntityAncestryItem struct {
+	// Entity in the ancestry.
+	Entity backstage.Entity `json:"entity"`
+
+	// ParentEntityRefs are the references of the entities that emitted the entity.
+	ParentEntityRefs []string `json:"parentEntityRefs"`
+}
+
+// GetEntityAncestry returns the ancestry of an entity of any kind, identified by its reference. The namespace defaults to the default one of
+// the client.
+func (c *Client) GetEntityAncestry(ctx context.Context, ref EntityRef) (*EntityAncestryResponse, *http.Response, error) {
+	if ref.Namespace == "" {
+		ref.Namespace = c.DefaultNamespace
+	}
+
+	path, _ := url.JoinPath(entitiesApiPath, "/by-name/", strings.ToLower(ref.Kind), ref.Namespace, ref.Name, "/ancestry")
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ancestry *EntityAncestryResponse
+	resp, err := c.do(req, &ancestry)
+
+	return ancestry, resp, err
+}
+
+// ValidateEntityResponse holds the result of the Client.ValidateEntity method.
+type ValidateEntityResponse struct {
+	// Errors are the problems found in the entity. The entity is valid, if there are none.
+	Errors []ValidateEntityError `json:"errors"`
+}
+
+// ValidateEntityError is a problem found in an entity by the Client.ValidateEntity method.
+type ValidateEntityError struct {
+	// Name of the error type, e.g. "InputError".
+	Name string `json:"name"`
+
+	// Message describing the problem.
+	Message string `json:"message"`
+}
+
+// validateEntityRequest is the body of the request sent by the Client.ValidateEntity method.
+type validateEntityRequest struct {
+	Entity   json.RawMessage `json:"entity"`
+	Location string          `json:"location"`
+}
+
+// ValidateEntity validates an entity descriptor, as if it was read from the location in the <type>:<target> format, without storing it in
+// the catalog. Unlike other methods, the response of an invalid entity (400 Bad Request) is decoded as well.
+func (c *Client) ValidateEntity(ctx context.Context, entity json.RawMessage, location string) (*ValidateEntityResponse, *http.Response, error) {
+	const validateEntityApiPath = "/catalog/validate-entity"
+
+	req, err := c.newRequest(transport.WithReadOnly(ctx), http.MethodPost, validateEntityApiPath, &validateEntityRequest{Entity: entity, Location: location})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	validation := &ValidateEntityResponse{Errors: []ValidateEntityError{}}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest {
+		return validation, resp, nil
+	}
+
+	// Malformed requests are rejected with a single error instead of a list of them.
+	var body struct {
+		Errors []ValidateEntityError `json:"errors"`
+		Error  *ValidateEntityError  `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil && err != io.EOF {
+		return validation, resp, err
+	}
+
+	validation.Errors = append(validation.Errors, body.Errors...)
+	if len(validation.Errors) == 0 && body.Error != nil {
+		validation.Errors = append(validation.Errors, *body.Error)
+	}
+
+	if len(validation.Errors) == 0 && resp.StatusCode == http.StatusBadRequest {
+		validation.Errors = append(validation.Errors, ValidateEntityError{Message: resp.Status})
+	}
+
+	return validation, resp, nil
+}
+
+// refreshEntityRequest is the body of the request sent by the Client.RefreshEntity method.
+type refreshEntityRequest struct {
+	EntityRef string `json:"entityRef"`
+}
+
+// RefreshEntity schedules the entity, identified by its reference in the <kind>:<namespace>/<name> format, to be processed again. For
+// locations, it re-reads their targets and updates the entities they emit. The refresh is asynchronous, so it is not finished, when the
+// method returns.
+func (c *Client) RefreshEntity(ctx context.Context, ref string) (*http.Response, error) {
+	const refreshApiPath = "/catalog/refresh"
+
+	req, err := c.newRequest(ctx, http.MethodPost, refreshApiPath, &refreshEntityRequest{EntityRef: ref})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEntityRef(t *testing.T) {
+	tests := map[string]struct {
+		ref      string
+		expected EntityRef
+		err      bool
+	}{
+		"full":              {ref: "component:custom/artist-web", expected: EntityRef{Kind: "component", Namespace: "custom", Name: "artist-web"}},
+		"without namespace": {ref: "Template:artist-web", expected: EntityRef{Kind: "Template", Namespace: "default", Name: "artist-web"}},
+		"without kind":      {ref: "custom/artist-web", expected: EntityRef{Kind: "Component", Namespace: "custom", Name: "artist-web"}},
+		"name only":         {ref: "artist-web", expected: EntityRef{Kind: "Component", Namespace: "default", Name: "artist-web"}},
+		"empty":             {ref: "", err: true},
+		"empty kind":        {ref: ":default/artist-web", err: true},
+		"empty name":        {ref: "component:default/", err: true},
+		"too many parts":    {ref: "component:default/artist/web", err: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ref, err := ParseEntityRef(tt.ref, "Component", "default")
+			if tt.err {
+				assert.Errorf(t, err, "Parsing %q should return an error", tt.ref)
+				return
+			}
+
+			assert.NoErrorf(t, err, "Parsing %q should not return an error", tt.ref)
+			assert.Equalf(t, tt.expected, ref, "Entity reference should be parsed")
+		})
+	}
+}
+
+func TestEntityRef_String(t *testing.T) {
+	ref := EntityRef{Kind: "Component", Namespace: "default", Name: "artist-web"}
+	assert.Equalf(t, "component:default/artist-web", ref.String(), "Entity reference should be formatted")
+}
+
+func TestClient_GetEntityByName(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodGet, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/catalog/entities/by-name/database/default/orders", r.URL.Path, "Request path should match")
+		_, _ = w.Write([]byte(`{"apiVersion":"example.com/v1","kind":"Database","metadata":{"name":"orders","namespace":"default"},` +
+			`"spec":{"engine":"postgres"}}`))
+	})
+
+	entity, resp, err := c.GetEntityByName(context.Background(), EntityRef{Kind: "Database", Name: "orders"})
+	assert.NoErrorf(t, err, "Getting entity should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	assert.Equalf(t, "Database", entity.Kind, "Entity kind should match")
+	assert.Equalf(t, "orders", entity.Metadata.Name, "Entity name should match")
+	assert.Equalf(t, "postgres", entity.Spec["engine"], "Entity spec should match")
+}
+
+func TestClient_QueryEntities(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, "/api/catalog/entities/by-query", r.URL.Path, "Request path should match")
+		assert.Equalf(t, "metadata.name,spec.owner", r.URL.Query().Get("fields"), "Fields should be sent")
+		assert.Equalf(t, "2", r.URL.Query().Get("limit"), "Limit should be sent")
+
+		if r.URL.Query().Get("cursor") == "" {
+			assert.Equalf(t, []string{"kind=component", "spec.type=service"}, r.URL.Query()["filter"], "Filters should be sent")
+			assert.Equalf(t, []string{"metadata.name,desc"}, r.URL.Query()["orderField"], "Order should be sent")
+			assert.Equalf(t, "payments", r.URL.Query().Get("fullTextFilterTerm"), "Full text term should be sent")
+			assert.Equalf(t, "metadata.title,metadata.description", r.URL.Query().Get("fullTextFilterFields"), "Full text fields should be sent")
+			_, _ = w.Write([]byte(`{"items":[{"metadata":{"name":"b"}},{"metadata":{"name":"a"}}],"totalItems":3,"pageInfo":{"nextCursor":"next"}}`))
+			return
+		}
+
+		assert.Equalf(t, "next", r.URL.Query().Get("cursor"), "Cursor should be sent")
+		assert.Emptyf(t, r.URL.Query()["filter"], "Filters should not be sent with cursor")
+		assert.Emptyf(t, r.URL.Query().Get("fullTextFilterTerm"), "Full text term should not be sent with cursor")
+		_, _ = w.Write([]byte(`{"items":[{"metadata":{"name":"0"}}],"totalItems":3,"pageInfo":{"prevCursor":"prev"}}`))
+	})
+
+	options := &QueryEntitiesOptions{
+		Filters:        []string{"kind=component", "spec.type=service"},
+		Fields:         []string{"metadata.name", "spec.owner"},
+		Order:          []backstage.ListEntityOrder{{Field: "metadata.name", Direction: backstage.OrderDescending}},
+		FullTextTerm:   "payments",
+		FullTextFields: []string{"metadata.title", "metadata.description"},
+		Limit:          2,
+	}
+
+	page, resp, err := c.QueryEntities(context.Background(), options)
+	assert.NoErrorf(t, err, "Querying entities should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	assert.Lenf(t, page.Items, 2, "First page should contain entities")
+	assert.Equalf(t, 3, page.TotalItems, "Total number of entities should match")
+	assert.Equalf(t, "next", page.PageInfo.NextCursor, "Next cursor should be returned")
+
+	options.Cursor = page.PageInfo.NextCursor
+	page, _, err = c.QueryEntities(context.Background(), options)
+	assert.NoErrorf(t, err, "Querying entities should not return an error")
+	assert.Lenf(t, page.Items, 1, "Last page should contain entities")
+	assert.Emptyf(t, page.PageInfo.NextCursor, "Last page should have no next cursor")
+
+	_, _, err = c.QueryEntities(context.Background(), &QueryEntitiesOptions{Order: []backstage.ListEntityOrder{{Field: "a", Direction: "up"}}})
+	assert.Errorf(t, err, "Invalid order direction should return an error")
+}
+
+func TestClient_GetEntityFacets(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, "/api/catalog/entity-facets", r.URL.Path, "Request path should match")
+		assert.Equalf(t, []string{"spec.type", "metadata.tags"}, r.URL.Query()["facet"], "Facets should be sent")
+		assert.Equalf(t, []string{"kind=component"}, r.URL.Query()["filter"], "Filters should be sent")
+		_, _ = w.Write([]byte(`{"facets":{"spec.type":[{"value":"service","count":3},{"value":"website","count":1}],"metadata.tags":[]}}`))
+	})
+
+	facets, resp, err := c.GetEntityFacets(context.Background(), &EntityFacetsOptions{
+		Facets:  []string{"spec.type", "metadata.tags"},
+		Filters: []string{"kind=component"},
+	})
+	assert.NoErrorf(t, err, "Getting entity facets should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	assert.Equalf(t, []EntityFacetValue{{Value: "service", Count: 3}, {Value: "website", Count: 1}}, facets.Facets["spec.type"],
+		"Facet values should match")
+	assert.Emptyf(t, facets.Facets["metadata.tags"], "Facet without values should be empty")
+}
+
+func TestClient_GetEntitiesByRefs(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodPost, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/catalog/entities/by-refs", r.URL.Path, "Request path should match")
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEqf(t, `{"entityRefs":["component:default/a","component:default/missing"]}`, string(body), "Request body should match")
+
+		_, _ = w.Write([]byte(`{"items":[{"kind":"Component","metadata":{"name":"a"}},null]}`))
+	})
+
+	entities, resp, err := c.GetEntitiesByRefs(context.Background(), []string{"component:default/a", "component:default/missing"}, nil)
+	assert.NoErrorf(t, err, "Getting entities should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	if assert.Lenf(t, entities.Items, 2, "Entities should be returned for all references") {
+		assert.Equalf(t, "a", entities.Items[0].Metadata.Name, "Found entity should be returned")
+		assert.Nilf(t, entities.Items[1], "Missing entity should be nil")
+	}
+}
+
+func TestClient_GetEntitiesByRefs_KeepsCache(t *testing.T) {
+	var gets int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets++
+			_, _ = w.Write([]byte(`{"kind":"Component","metadata":{"name":"test"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"items":[null]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL, "", transport.NewCacheTransport(time.Minute, nil).Client())
+	assert.NoErrorf(t, err, "Client should be created")
+
+	ref := EntityRef{Kind: "Component", Name: "test"}
+	_, _, err = c.GetEntityByName(context.Background(), ref)
+	assert.NoErrorf(t, err, "Getting entity should not return an error")
+	_, _, err = c.GetEntitiesByRefs(context.Background(), []string{"component:default/other"}, nil)
+	assert.NoErrorf(t, err, "Getting entities should not return an error")
+	_, _, err = c.GetEntityByName(context.Background(), ref)
+	assert.NoErrorf(t, err, "Getting entity should not return an error")
+
+	assert.Equalf(t, 1, gets, "Entity should be served from cache after looking up entities by refs")
+}
+
+func TestClient_GetEntityAncestry(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, "/api/catalog/entities/by-name/component/default/artist-web/ancestry", r.URL.Path, "Request path should match")
+		_, _ = w.Write([]byte(`{"rootEntityRef":"component:default/artist-web","items":[` +
+			`{"entity":{"kind":"Component","metadata":{"name":"artist-web"}},"parentEntityRefs":["location:default/generated-1"]},` +
+			`{"entity":{"kind":"Location","metadata":{"name":"generated-1"}},"parentEntityRefs":[]}]}`))
+	})
+
+	ancestry, resp, err := c.GetEntityAncestry(context.Background(), EntityRef{Kind: "Component", Name: "artist-web"})
+	assert.NoErrorf(t, err, "Getting entity ancestry should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	assert.Equalf(t, "component:default/artist-web", ancestry.RootEntityRef, "Root entity reference should match")
+	if assert.Lenf(t, ancestry.Items, 2, "Ancestry should contain all entities") {
+		assert.Equalf(t, []string{"location:default/generated-1"}, ancestry.Items[0].ParentEntityRefs, "Parent references should match")
+		assert.Equalf(t, "Location", ancestry.Items[1].Entity.Kind, "Ancestor should match")
+	}
+}
+
+func TestClient_ValidateEntity(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodPost, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/catalog/validate-entity", r.URL.Path, "Request path should match")
+
+		var body struct {
+			Entity struct {
+				Kind string `json:"kind"`
+			} `json:"entity"`
+			Location string `json:"location"`
+		}
+		assert.NoErrorf(t, json.NewDecoder(r.Body).Decode(&body), "Request body should be decoded")
+		assert.Equalf(t, "url:https://github.com/example/repo/blob/main/catalog-info.yaml", body.Location, "Request location should match")
+
+		switch body.Entity.Kind {
+		case "Component":
+			w.WriteHeader(http.StatusOK)
+		case "":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"name":"InputError","message":"Malformed request"}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":[{"name":"InputError","message":"Unsupported kind"}]}`))
+		}
+	})
+
+	location := "url:https://github.com/example/repo/blob/main/catalog-info.yaml"
+	tests := map[string]struct {
+		entity   string
+		expected []ValidateEntityError
+	}{
+		"valid entity":      {entity: `{"kind":"Component"}`, expected: []ValidateEntityError{}},
+		"invalid entity":    {entity: `{"kind":"Unknown"}`, expected: []ValidateEntityError{{Name: "InputError", Message: "Unsupported kind"}}},
+		"malformed request": {entity: `{}`, expected: []ValidateEntityError{{Name: "InputError", Message: "Malformed request"}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			validation, _, err := c.ValidateEntity(context.Background(), json.RawMessage(test.entity), location)
+			assert.NoErrorf(t, err, "Validating entity should not return an error")
+			assert.Equalf(t, test.expected, validation.Errors, "Validation errors should match")
+		})
+	}
+}
+
+func TestClient_RefreshEntity(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodPost, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/catalog/refresh", r.URL.Path, "Request path should match")
+
+		var body struct {
+			EntityRef string `json:"entityRef"`
+		}
+		assert.NoErrorf(t, json.NewDecoder(r.Body).Decode(&body), "Request body should be decoded")
+		assert.Equalf(t, "location:default/generated-1", body.EntityRef, "Request entity ref should match")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	resp, err := c.RefreshEntity(context.Background(), "location:default/generated-1")
+	assert.NoErrorf(t, err, "Refreshing entity should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+}
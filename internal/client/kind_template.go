@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/datolabs-io/go-backstage/v3"
+)
+
+// KindTemplate defines name for template kind.
+const KindTemplate = "Template"
+
+// TemplateEntityV1beta3 describes a Scaffolder template, which can be used to create new software components.
+// https://github.com/backstage/backstage/blob/master/plugins/scaffolder-common/src/Template.v1beta3.schema.json
+type TemplateEntityV1beta3 struct {
+	backstage.Entity
+
+	// ApiVersion is always "scaffolder.backstage.io/v1beta3".
+	ApiVersion string `json:"apiVersion" yaml:"apiVersion"`
+
+	// Kind is always "Template".
+	Kind string `json:"kind" yaml:"kind"`
+
+	// Spec is the specification data describing the template itself.
+	Spec *TemplateEntityV1beta3Spec `json:"spec" yaml:"spec"`
+}
+
+// TemplateEntityV1beta3Spec describes the specification data describing the template itself.
+type TemplateEntityV1beta3Spec struct {
+	// Type of component created by the template, e.g. "website".
+	Type string `json:"type" yaml:"type"`
+
+	// Owner is an entity reference to the owner of the template.
+	Owner string `json:"owner,omitempty" yaml:"owner,omitempty"`
+
+	// Parameters is a JSONSchema, or a list of JSONSchemas (one per form step), describing the inputs of the template.
+	Parameters json.RawMessage `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+
+	// Steps is a list of steps to execute.
+	Steps []TemplateEntityV1beta3Step `json:"steps" yaml:"steps"`
+
+	// Output is a description of the outputs of the template, e.g. links and text.
+	Output map[string]interface{} `json:"output,omitempty" yaml:"output,omitempty"`
+}
+
+// TemplateEntityV1beta3Step describes a single step of the template.
+type TemplateEntityV1beta3Step struct {
+	// ID of the step.
+	ID string `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name of the step.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Action executed by the step, e.g. "fetch:template".
+	Action string `json:"action" yaml:"action"`
+
+	// Input of the action.
+	Input map[string]interface{} `json:"input,omitempty" yaml:"input,omitempty"`
+}
+
+// GetTemplate returns a template entity identified by the name and the namespace (the default one of the client, if not specified) it
+// belongs to.
+func (c *Client) GetTemplate(ctx context.Context, n string, ns string) (*TemplateEntityV1beta3, *http.Response, error) {
+	if ns == "" {
+		ns = c.DefaultNamespace
+	}
+
+	path, _ := url.JoinPath(entitiesApiPath, "/by-name/", strings.ToLower(KindTemplate), ns, n)
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var template *TemplateEntityV1beta3
+	resp, err := c.do(req, &template)
+
+	return template, resp, err
+}
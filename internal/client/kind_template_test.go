@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetTemplate(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, "/api/catalog/entities/by-name/template/default/react-ssr-template", r.URL.Path, "Request path should match")
+		_, _ = w.Write([]byte(`{"apiVersion":"scaffolder.backstage.io/v1beta3","kind":"Template","metadata":{"name":"react-ssr-template"},` +
+			`"spec":{"type":"website","owner":"web@example.com","parameters":[{"title":"Name","properties":{"name":{"type":"string"}}}],` +
+			`"steps":[{"id":"fetch","name":"Fetch","action":"fetch:template","input":{"url":"./template"}}],` +
+			`"output":{"links":[{"title":"Repository","url":"${{ steps.publish.output.remoteUrl }}"}]}}}`))
+	})
+
+	template, resp, err := c.GetTemplate(context.Background(), "react-ssr-template", "")
+	assert.NoErrorf(t, err, "Getting template should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	assert.Equalf(t, KindTemplate, template.Kind, "Template kind should match")
+	assert.Equalf(t, "react-ssr-template", template.Metadata.Name, "Template name should match")
+	assert.Equalf(t, "website", template.Spec.Type, "Template type should match")
+	assert.JSONEqf(t, `[{"title":"Name","properties":{"name":{"type":"string"}}}]`, string(template.Spec.Parameters), "Template parameters should match")
+	if assert.Lenf(t, template.Spec.Steps, 1, "Template should have steps") {
+		assert.Equalf(t, "fetch:template", template.Spec.Steps[0].Action, "Step action should match")
+		assert.Equalf(t, "./template", template.Spec.Steps[0].Input["url"], "Step input should match")
+	}
+	assert.Containsf(t, template.Spec.Output, "links", "Template output should match")
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
+)
+
+const locationsApiPath = "/catalog/locations"
+
+const (
+	// LocationPresenceRequired is the presence of a location, whose target must exist. It is the default one.
+	LocationPresenceRequired = "required"
+
+	// LocationPresenceOptional is the presence of a location, whose target may not exist (yet).
+	LocationPresenceOptional = "optional"
+)
+
+// LocationSpec identifies a location by its type and target.
+type LocationSpec struct {
+	// Type of the location, e.g. "url".
+	Type string `json:"type"`
+
+	// Target of the location, e.g. an URL of the catalog-info.yaml file.
+	Target string `json:"target"`
+}
+
+// CreateLocationOptions specifies the parameters to the Client.CreateLocation method.
+type CreateLocationOptions struct {
+	// Type of the location, e.g. "url" or "file".
+	Type string `json:"type"`
+
+	// Target of the location, e.g. an URL of the catalog-info.yaml file for "url" type.
+	Target string `json:"target"`
+
+	// Presence of the location: "required" (default, if empty) or "optional".
+	Presence string `json:"presence,omitempty"`
+}
+
+// CreateLocation registers a new location of any type. In dry run mode, the location is not stored, but the entities it would emit are
+// returned.
+func (c *Client) CreateLocation(ctx context.Context, options *CreateLocationOptions, dryRun bool) (*backstage.LocationCreateResponse, *http.Response, error) {
+	if dryRun {
+		ctx = transport.WithReadOnly(ctx)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("%s?dryRun=%t", locationsApiPath, dryRun), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var location *backstage.LocationCreateResponse
+	resp, err := c.do(req, &location)
+
+	return location, resp, err
+}
+
+// GetLocation returns a location identified by its ID. The response is never served from cache, so that locations deleted or changed
+// outside of Terraform are detected.
+func (c *Client) GetLocation(ctx context.Context, id string) (*backstage.LocationResponse, *http.Response, error) {
+	path, _ := url.JoinPath(locationsApiPath, id)
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Cache-Control", "no-cache")
+
+	var location *backstage.LocationResponse
+	resp, err := c.do(req, &location)
+
+	return location, resp, err
+}
+
+// AnalyzeLocationOptions specifies the parameters to the Client.AnalyzeLocation method.
+type AnalyzeLocationOptions struct {
+	// Location to analyze.
+	Location LocationSpec `json:"location"`
+
+	// CatalogFilename is the name of the catalog file to look for in the location, e.g. "catalog-info.yaml".
+	CatalogFilename string `json:"catalogFilename,omitempty"`
+}
+
+// AnalyzeLocationResponse holds the entities, which would be discovered in the analyzed location.
+type AnalyzeLocationResponse struct {
+	// ExistingEntityFiles are the entities read from catalog files already present in the location.
+	ExistingEntityFiles []AnalyzeLocationExistingEntity `json:"existingEntityFiles"`
+
+	// GenerateEntities are the entities, which could be generated for the location, if it has no catalog files.
+	GenerateEntities []AnalyzeLocationGenerateEntity `json:"generateEntities"`
+}
+
+// AnalyzeLocationExistingEntity is an entity read from a catalog file present in the analyzed location.
+type AnalyzeLocationExistingEntity struct {
+	// Entity read from the catalog file, as it is defined there.
+	Entity json.RawMessage `json:"entity"`
+
+	// Location of the catalog file.
+	Location LocationSpec `json:"location"`
+
+	// IsRegistered is true, if the catalog file is already registered in the catalog.
+	IsRegistered bool `json:"isRegistered"`
+}
+
+// AnalyzeLocationGenerateEntity is a partial entity, which could be generated for the analyzed location.
+type AnalyzeLocationGenerateEntity struct {
+	// Entity is the partial entity, as suggested by the analysis.
+	Entity json.RawMessage `json:"entity"`
+
+	// Fields are the fields of the entity, along with the way their values were determined.
+	Fields []AnalyzeLocationEntityField `json:"fields"`
+}
+
+// AnalyzeLocationEntityField is a field of a generated entity.
+type AnalyzeLocationEntityField struct {
+	// Field is the path of the field in the entity, e.g. "spec.owner".
+	Field string `json:"field"`
+
+	// State describes the way the value was determined: "analysisSuggestedValue", "analysisSuggestedNoValue" or "needsUserInput".
+	State string `json:"state"`
+
+	// Value suggested by the analysis, if any.
+	Value *string `json:"value"`
+
+	// Description of the field.
+	Description string `json:"description"`
+}
+
+// AnalyzeLocation returns the entities, which would be discovered in the location, without registering it.
+func (c *Client) AnalyzeLocation(ctx context.Context, options *AnalyzeLocationOptions) (*AnalyzeLocationResponse, *http.Response, error) {
+	const analyzeLocationApiPath = "/catalog/analyze-location"
+
+	req, err := c.newRequest(transport.WithReadOnly(ctx), http.MethodPost, analyzeLocationApiPath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var analysis *AnalyzeLocationResponse
+	resp, err := c.do(req, &analysis)
+
+	return analysis, resp, err
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_AnalyzeLocation(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodPost, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/catalog/analyze-location", r.URL.Path, "Request path should match")
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEqf(t, `{"location":{"type":"url","target":"https://github.com/example/repo"}}`, string(body), "Request body should match")
+
+		_, _ = w.Write([]byte(`{"existingEntityFiles":[{"entity":{"kind":"Component","metadata":{"name":"artist-web"}},` +
+			`"location":{"type":"url","target":"https://github.com/example/repo/blob/main/catalog-info.yaml"},"isRegistered":true}],` +
+			`"generateEntities":[{"entity":{"kind":"Component","metadata":{"name":"repo"}},` +
+			`"fields":[{"field":"spec.owner","state":"needsUserInput","value":null,"description":"Owner of the component"}]}]}`))
+	})
+
+	analysis, resp, err := c.AnalyzeLocation(context.Background(), &AnalyzeLocationOptions{
+		Location: LocationSpec{Type: "url", Target: "https://github.com/example/repo"},
+	})
+	assert.NoErrorf(t, err, "Analyzing location should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	if assert.Lenf(t, analysis.ExistingEntityFiles, 1, "Analysis should contain existing entities") {
+		assert.JSONEqf(t, `{"kind":"Component","metadata":{"name":"artist-web"}}`, string(analysis.ExistingEntityFiles[0].Entity), "Existing entity should match")
+		assert.Truef(t, analysis.ExistingEntityFiles[0].IsRegistered, "Existing entity should be registered")
+	}
+	if assert.Lenf(t, analysis.GenerateEntities, 1, "Analysis should contain generated entities") {
+		assert.JSONEqf(t, `{"kind":"Component","metadata":{"name":"repo"}}`, string(analysis.GenerateEntities[0].Entity), "Generated entity should match")
+		assert.Nilf(t, analysis.GenerateEntities[0].Fields[0].Value, "Field without value should be nil")
+	}
+}
+
+func TestClient_CreateLocation(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodPost, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/catalog/locations", r.URL.Path, "Request path should match")
+		assert.Equalf(t, "false", r.URL.Query().Get("dryRun"), "Dry run query parameter should match")
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEqf(t, `{"type":"file","target":"/etc/backstage/catalog-info.yaml","presence":"optional"}`, string(body), "Request body should match")
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"location":{"id":"a1b2c3","type":"file","target":"/etc/backstage/catalog-info.yaml"},"entities":[]}`))
+	})
+
+	location, resp, err := c.CreateLocation(context.Background(), &CreateLocationOptions{
+		Type: "file", Target: "/etc/backstage/catalog-info.yaml", Presence: LocationPresenceOptional,
+	}, false)
+	assert.NoErrorf(t, err, "Creating location should not return an error")
+	assert.Equalf(t, http.StatusCreated, resp.StatusCode, "Response status should be Created")
+	assert.Equalf(t, "a1b2c3", location.Location.ID, "Location ID should match")
+	assert.Equalf(t, "file", location.Location.Type, "Location type should match")
+}
+
+func TestClient_GetLocation(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodGet, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/catalog/locations/a1b2c3", r.URL.Path, "Request path should match")
+		assert.Equalf(t, "no-cache", r.Header.Get("Cache-Control"), "Request should bypass the cache")
+		_, _ = w.Write([]byte(`{"id":"a1b2c3","type":"url","target":"https://example.com/catalog-info.yaml"}`))
+	})
+
+	location, resp, err := c.GetLocation(context.Background(), "a1b2c3")
+	assert.NoErrorf(t, err, "Getting location should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	assert.Equalf(t, "https://example.com/catalog-info.yaml", location.Target, "Location target should match")
+}
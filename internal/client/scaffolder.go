@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
+)
+
+const scaffolderTasksApiPath = "/scaffolder/v2/tasks"
+
+// ScaffolderTaskStatus is the status of a Scaffolder task.
+type ScaffolderTaskStatus string
+
+const (
+	// ScaffolderTaskStatusOpen is the status of a task waiting to be picked up by a worker.
+	ScaffolderTaskStatusOpen ScaffolderTaskStatus = "open"
+
+	// ScaffolderTaskStatusProcessing is the status of a task being executed.
+	ScaffolderTaskStatusProcessing ScaffolderTaskStatus = "processing"
+
+	// ScaffolderTaskStatusCompleted is the status of a successfully finished task.
+	ScaffolderTaskStatusCompleted ScaffolderTaskStatus = "completed"
+
+	// ScaffolderTaskStatusFailed is the status of a task, which finished with an error.
+	ScaffolderTaskStatusFailed ScaffolderTaskStatus = "failed"
+
+	// ScaffolderTaskStatusCancelled is the status of a task, which was cancelled before it finished.
+	ScaffolderTaskStatusCancelled ScaffolderTaskStatus = "cancelled"
+)
+
+// Finished returns true, if the task is not going to change its status anymore.
+func (s ScaffolderTaskStatus) Finished() bool {
+	return s == ScaffolderTaskStatusCompleted || s == ScaffolderTaskStatusFailed || s == ScaffolderTaskStatusCancelled
+}
+
+// ScaffolderTask is a task executing a Scaffolder template.
+type ScaffolderTask struct {
+	// ID of the task.
+	ID string `json:"id"`
+
+	// Spec is the specification of the task, i.e. the template steps and the values it is executed with.
+	Spec json.RawMessage `json:"spec,omitempty"`
+
+	// Status of the task.
+	Status ScaffolderTaskStatus `json:"status"`
+
+	// CreatedAt is the time the task was created at.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// LastHeartbeatAt is the last time the worker executing the task reported it to be alive.
+	LastHeartbeatAt string `json:"lastHeartbeatAt,omitempty"`
+}
+
+// ScaffolderTaskEvent is an event, e.g. a log message or the completion, emitted by a Scaffolder task.
+type ScaffolderTaskEvent struct {
+	// ID of the event, which increases with each event.
+	ID int64 `json:"id"`
+
+	// TaskID is the ID of the task that emitted the event.
+	TaskID string `json:"taskId"`
+
+	// Type of the event: "log", "completion", "cancelled" or "recovered".
+	Type string `json:"type"`
+
+	// CreatedAt is the time the event was created at.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// Body of the event.
+	Body ScaffolderTaskEventBody `json:"body"`
+}
+
+// ScaffolderTaskEventBody is the body of an event emitted by a Scaffolder task.
+type ScaffolderTaskEventBody struct {
+	// Message of the event.
+	Message string `json:"message,omitempty"`
+
+	// StepID is the ID of the template step the event relates to, if any.
+	StepID string `json:"stepId,omitempty"`
+
+	// Status of the step or the task the event relates to, if any.
+	Status string `json:"status,omitempty"`
+
+	// Output of the task, present in the completion event.
+	Output *ScaffolderTaskOutput `json:"output,omitempty"`
+}
+
+// ScaffolderTaskOutput is the output of a finished Scaffolder task, as described by the output of its template.
+type ScaffolderTaskOutput struct {
+	// Links to resources created by the task, e.g. the repository or the catalog entity.
+	Links []ScaffolderTaskOutputLink `json:"links,omitempty"`
+
+	// Raw is the complete output of the task, including any custom values.
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the output, keeping the complete output in Raw.
+func (o *ScaffolderTaskOutput) UnmarshalJSON(data []byte) error {
+	var output struct {
+		Links []ScaffolderTaskOutputLink `json:"links,omitempty"`
+	}
+	if err := json.Unmarshal(data, &output); err != nil {
+		return err
+	}
+
+	o.Links = output.Links
+	o.Raw = append(json.RawMessage{}, data...)
+
+	return nil
+}
+
+// EntityRef returns the entity reference of the task output, which is either set as "entityRef" output or in one of the links.
+func (o *ScaffolderTaskOutput) EntityRef() string {
+	var output struct {
+		EntityRef string `json:"entityRef,omitempty"`
+	}
+	if len(o.Raw) > 0 && json.Unmarshal(o.Raw, &output) == nil && output.EntityRef != "" {
+		return output.EntityRef
+	}
+
+	for _, l := range o.Links {
+		if l.EntityRef != "" {
+			return l.EntityRef
+		}
+	}
+
+	return ""
+}
+
+// ScaffolderTaskOutputLink is a link in the output of a Scaffolder task.
+type ScaffolderTaskOutputLink struct {
+	// Title of the link.
+	Title string `json:"title,omitempty"`
+
+	// URL of the link.
+	URL string `json:"url,omitempty"`
+
+	// EntityRef is a reference of the catalog entity the link points to.
+	EntityRef string `json:"entityRef,omitempty"`
+
+	// Icon is a key representing a visual icon to be displayed in the UI.
+	Icon string `json:"icon,omitempty"`
+}
+
+type createScaffolderTaskRequest struct {
+	TemplateRef string          `json:"templateRef"`
+	Values      json.RawMessage `json:"values"`
+}
+
+type createScaffolderTaskResponse struct {
+	ID string `json:"id"`
+}
+
+// CreateScaffolderTask starts a new Scaffolder task, executing the template identified by the reference with the values, and returns ID
+// of the task.
+func (c *Client) CreateScaffolderTask(ctx context.Context, templateRef string, values json.RawMessage) (string, *http.Response, error) {
+	if len(values) == 0 {
+		values = json.RawMessage("{}")
+	}
+
+	// Every attempt starts a new task, so the request is never retried, even if it times out after the server accepted it.
+	req, err := c.newRequest(transport.WithoutRetries(ctx), http.MethodPost, scaffolderTasksApiPath,
+		&createScaffolderTaskRequest{TemplateRef: templateRef, Values: values})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var task createScaffolderTaskResponse
+	resp, err := c.do(req, &task)
+
+	return task.ID, resp, err
+}
+
+// GetScaffolderTask returns a Scaffolder task identified by its ID. The response is never served from cache, as the task is expected to
+// be polled until it finishes.
+func (c *Client) GetScaffolderTask(ctx context.Context, id string) (*ScaffolderTask, *http.Response, error) {
+	path, _ := url.JoinPath(scaffolderTasksApiPath, id)
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Cache-Control", "no-cache")
+
+	var task *ScaffolderTask
+	resp, err := c.do(req, &task)
+
+	return task, resp, err
+}
+
+// ListScaffolderTaskEvents returns events emitted by a Scaffolder task identified by its ID, which have an ID greater than the after one.
+func (c *Client) ListScaffolderTaskEvents(ctx context.Context, id string, after int64) ([]ScaffolderTaskEvent, *http.Response, error) {
+	path, _ := url.JoinPath(scaffolderTasksApiPath, id, "/events")
+	if after > 0 {
+		path = fmt.Sprintf("%s?%s", path, url.Values{"after": []string{strconv.FormatInt(after, 10)}}.Encode())
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Cache-Control", "no-cache")
+
+	var events []ScaffolderTaskEvent
+	resp, err := c.do(req, &events)
+
+	return events, resp, err
+}
+
+// CancelScaffolderTask requests cancellation of a Scaffolder task identified by its ID.
+func (c *Client) CancelScaffolderTask(ctx context.Context, id string) (*http.Response, error) {
+	path, _ := url.JoinPath(scaffolderTasksApiPath, id, "/cancel")
+	req, err := c.newRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/datolabs-io/terraform-provider-backstage/internal/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateScaffolderTask(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodPost, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/scaffolder/v2/tasks", r.URL.Path, "Request path should match")
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEqf(t, `{"templateRef":"template:default/react-ssr-template","values":{"name":"test"}}`, string(body), "Request body should match")
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"a1b2c3"}`))
+	})
+
+	id, resp, err := c.CreateScaffolderTask(context.Background(), "template:default/react-ssr-template", json.RawMessage(`{"name":"test"}`))
+	assert.NoErrorf(t, err, "Creating task should not return an error")
+	assert.Equalf(t, http.StatusCreated, resp.StatusCode, "Response status should be Created")
+	assert.Equalf(t, "a1b2c3", id, "Task ID should match")
+}
+
+func TestClient_CreateScaffolderTask_NotRetried(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(server.Close)
+
+	retryPolicy := transport.RetryPolicy{MaxAttempts: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+	c, err := NewClient(server.URL, "", transport.NewRetryableClient(retryPolicy, nil, time.Second))
+	assert.NoErrorf(t, err, "Client should be created")
+
+	_, resp, err := c.CreateScaffolderTask(context.Background(), "template:default/react-ssr-template", nil)
+	assert.NoErrorf(t, err, "Creating task should not return an error")
+	assert.Equalf(t, http.StatusBadGateway, resp.StatusCode, "Response of the only attempt should be returned")
+	assert.Equalf(t, 1, requests, "Request starting a task should not be retried")
+}
+
+func TestClient_GetScaffolderTask(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, "/api/scaffolder/v2/tasks/a1b2c3", r.URL.Path, "Request path should match")
+		assert.Equalf(t, "no-cache", r.Header.Get("Cache-Control"), "Request should not be served from cache")
+		_, _ = w.Write([]byte(`{"id":"a1b2c3","spec":{"steps":[]},"status":"processing","createdAt":"2024-01-01T00:00:00Z"}`))
+	})
+
+	task, resp, err := c.GetScaffolderTask(context.Background(), "a1b2c3")
+	assert.NoErrorf(t, err, "Getting task should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	assert.Equalf(t, ScaffolderTaskStatusProcessing, task.Status, "Task status should match")
+	assert.Falsef(t, task.Status.Finished(), "Processing task should not be finished")
+	assert.Equalf(t, "2024-01-01T00:00:00Z", task.CreatedAt, "Task creation time should match")
+}
+
+func TestClient_ListScaffolderTaskEvents(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, "/api/scaffolder/v2/tasks/a1b2c3/events", r.URL.Path, "Request path should match")
+		assert.Equalf(t, "1", r.URL.Query().Get("after"), "After query parameter should match")
+		_, _ = w.Write([]byte(`[{"id":2,"taskId":"a1b2c3","type":"log","body":{"message":"Fetching","stepId":"fetch","status":"processing"}},` +
+			`{"id":3,"taskId":"a1b2c3","type":"completion","body":{"message":"Run completed","status":"completed","output":` +
+			`{"links":[{"title":"Repository","url":"https://github.com/example/test"},{"title":"Catalog","entityRef":"component:default/test"}],` +
+			`"remoteUrl":"https://github.com/example/test"}}}]`))
+	})
+
+	events, resp, err := c.ListScaffolderTaskEvents(context.Background(), "a1b2c3", 1)
+	assert.NoErrorf(t, err, "Listing task events should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+	if assert.Lenf(t, events, 2, "Task should have events") {
+		assert.Equalf(t, "fetch", events[0].Body.StepID, "Event step ID should match")
+		assert.Nilf(t, events[0].Body.Output, "Log event should not have output")
+		if assert.NotNilf(t, events[1].Body.Output, "Completion event should have output") {
+			assert.Lenf(t, events[1].Body.Output.Links, 2, "Output links should be decoded")
+			assert.Equalf(t, "component:default/test", events[1].Body.Output.EntityRef(), "Output entity ref should be taken from links")
+			assert.Containsf(t, string(events[1].Body.Output.Raw), "remoteUrl", "Raw output should contain custom values")
+		}
+	}
+}
+
+func TestClient_CancelScaffolderTask(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, http.MethodPost, r.Method, "Request method should match")
+		assert.Equalf(t, "/api/scaffolder/v2/tasks/a1b2c3/cancel", r.URL.Path, "Request path should match")
+		_, _ = w.Write([]byte(`{"status":"cancelled"}`))
+	})
+
+	resp, err := c.CancelScaffolderTask(context.Background(), "a1b2c3")
+	assert.NoErrorf(t, err, "Cancelling task should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Response status should be OK")
+}
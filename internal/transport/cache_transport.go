@@ -0,0 +1,201 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+)
+
+// CacheTransport is a http.RoundTripper that caches successful responses to GET requests in memory for the configured TTL, and coalesces
+// identical requests in flight, so that they share one HTTP call, which is not canceled along with the request starting it. Any other request (e.g. POST or DELETE) invalidates the whole cache,
+// unless its context is marked by WithReadOnly, e.g. a POST looking entities up by their references. GET requests with the "Cache-Control: no-cache" header, e.g. polling of a long-running task, bypass the cache.
+type CacheTransport struct {
+	// BaseTransport is the underlying HTTP transport to use when making requests. It will default to http.DefaultTransport if nil.
+	BaseTransport http.RoundTripper
+
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+}
+
+// cachedResponse holds a response read into memory.
+type cachedResponse struct {
+	status     string
+	statusCode int
+	proto      string
+	header     http.Header
+	body       []byte
+	created    time.Time
+}
+
+// NewCacheTransport returns a new CacheTransport, caching responses for the given TTL.
+func NewCacheTransport(ttl time.Duration, base http.RoundTripper) *CacheTransport {
+	return &CacheTransport{
+		BaseTransport: base,
+		ttl:           ttl,
+		entries:       make(map[string]*cachedResponse),
+	}
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		if !readOnly(req) {
+			t.invalidate()
+		}
+		return t.transport().RoundTrip(req)
+	}
+
+	if noCache(req) {
+		return t.transport().RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	key := req.URL.String()
+
+	if cached := t.get(key); cached != nil {
+		tflog.Debug(ctx, "Serving response from cache", map[string]interface{}{
+			"backstage_http_url":         key,
+			"backstage_cache_age_ms":     time.Since(cached.created).Milliseconds(),
+			"backstage_http_status_code": cached.statusCode,
+		})
+
+		return cached.response(req), nil
+	}
+
+	// The shared call must outlive the caller starting it, as the others waiting for it may not be canceled yet.
+	shared := req.Clone(context.WithoutCancel(ctx))
+	result := t.group.DoChan(key, func() (interface{}, error) {
+		resp, err := t.transport().RoundTrip(shared)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		cached := &cachedResponse{
+			status:     resp.Status,
+			statusCode: resp.StatusCode,
+			proto:      resp.Proto,
+			header:     resp.Header.Clone(),
+			body:       body,
+			created:    time.Now(),
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			t.set(key, cached)
+		}
+
+		return cached, nil
+	})
+
+	var res singleflight.Result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res = <-result:
+	}
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.Shared {
+		tflog.Debug(ctx, "Shared response of identical request in flight", map[string]interface{}{"backstage_http_url": key})
+	}
+
+	return res.Val.(*cachedResponse).response(req), nil
+}
+
+// Client returns an *http.Client that makes cached requests.
+func (t *CacheTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// get returns the cached response for the key, if it exists and has not expired yet.
+func (t *CacheTransport) get(key string) *cachedResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cached, ok := t.entries[key]
+	if !ok {
+		return nil
+	}
+
+	if time.Since(cached.created) > t.ttl {
+		delete(t.entries, key)
+		return nil
+	}
+
+	return cached
+}
+
+// set stores the response for the key.
+func (t *CacheTransport) set(key string, cached *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries[key] = cached
+}
+
+// invalidate removes all the cached responses.
+func (t *CacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries = make(map[string]*cachedResponse)
+}
+
+// transport returns the underlying HTTP transport. If none is set, http.DefaultTransport is used.
+func (t *CacheTransport) transport() http.RoundTripper {
+	if t.BaseTransport != nil {
+		return t.BaseTransport
+	}
+
+	return http.DefaultTransport
+}
+
+// response returns a new *http.Response for the request, with its own copy of the cached headers and body.
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        c.status,
+		StatusCode:    c.statusCode,
+		Proto:         c.proto,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+// noCache returns true, if the request asks not to be served from cache.
+func noCache(req *http.Request) bool {
+	return strings.Contains(strings.ToLower(req.Header.Get("Cache-Control")), "no-cache")
+}
+
+// readOnlyKey is the context key marking non-GET requests, which do not change any data.
+type readOnlyKey struct{}
+
+// WithReadOnly returns a copy of the context, whose non-GET requests do not invalidate the cache of CacheTransport, as they only read data,
+// e.g. a POST validating an entity. Responses to such requests are not cached.
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+// readOnly returns true, if the request is marked as not changing any data.
+func readOnly(req *http.Request) bool {
+	ro, _ := req.Context().Value(readOnlyKey{}).(bool)
+	return ro
+}
@@ -0,0 +1,154 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newCountingServer returns a server responding with the given status after the delay, and a counter of the received requests.
+func newCountingServer(t *testing.T, status int, delay time.Duration) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		time.Sleep(delay)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"kind":"Component"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestCacheTransport_ResponsesCached(t *testing.T) {
+	server, requests := newCountingServer(t, http.StatusOK, 0)
+	client := NewCacheTransport(time.Minute, nil).Client()
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL + "/entities/by-name/component/default/test")
+		assert.NoErrorf(t, err, "Request should not return an error")
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equalf(t, `{"kind":"Component"}`, string(body), "Cached response should contain the body")
+	}
+	assert.EqualValuesf(t, 1, requests.Load(), "Identical requests should be served from cache")
+
+	_, _ = client.Get(server.URL + "/entities/by-name/component/default/other")
+	assert.EqualValuesf(t, 2, requests.Load(), "Different requests should not be served from cache")
+
+	req, _ := http.NewRequest(http.MethodDelete, server.URL+"/locations/1", nil)
+	_, _ = client.Do(req)
+	_, _ = client.Get(server.URL + "/entities/by-name/component/default/test")
+	assert.EqualValuesf(t, 4, requests.Load(), "Cache should be invalidated by non-GET requests")
+}
+
+func TestCacheTransport_ResponsesExpired(t *testing.T) {
+	server, requests := newCountingServer(t, http.StatusOK, 0)
+	client := NewCacheTransport(10*time.Millisecond, nil).Client()
+
+	_, _ = client.Get(server.URL)
+	time.Sleep(20 * time.Millisecond)
+	_, _ = client.Get(server.URL)
+
+	assert.EqualValuesf(t, 2, requests.Load(), "Expired responses should not be served from cache")
+}
+
+func TestCacheTransport_ErrorsNotCached(t *testing.T) {
+	server, requests := newCountingServer(t, http.StatusServiceUnavailable, 0)
+	client := NewCacheTransport(time.Minute, nil).Client()
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		assert.NoErrorf(t, err, "Request should not return an error")
+		assert.Equalf(t, http.StatusServiceUnavailable, resp.StatusCode, "Response status should be preserved")
+	}
+
+	assert.EqualValuesf(t, 2, requests.Load(), "Unsuccessful responses should not be cached")
+}
+
+func TestCacheTransport_RequestsCoalesced(t *testing.T) {
+	server, requests := newCountingServer(t, http.StatusServiceUnavailable, 50*time.Millisecond)
+	client := NewCacheTransport(time.Minute, nil).Client()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL)
+			if assert.NoErrorf(t, err, "Request should not return an error") {
+				body, _ := io.ReadAll(resp.Body)
+				assert.Equalf(t, `{"kind":"Component"}`, string(body), "Shared response should contain the body")
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValuesf(t, 1, requests.Load(), "Identical requests in flight should share one call")
+}
+
+func TestCacheTransport_CanceledRequestNotShared(t *testing.T) {
+	server, requests := newCountingServer(t, http.StatusOK, 100*time.Millisecond)
+	client := NewCacheTransport(time.Minute, nil).Client()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		_, err := client.Do(req)
+		assert.ErrorIsf(t, err, context.Canceled, "Canceled request should return an error")
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	resp, err := client.Get(server.URL)
+	if assert.NoErrorf(t, err, "Request waiting for the canceled one should not return an error") {
+		assert.Equalf(t, http.StatusOK, resp.StatusCode, "Shared response should be returned")
+	}
+	wg.Wait()
+
+	assert.EqualValuesf(t, 1, requests.Load(), "Identical requests in flight should share one call")
+}
+
+func TestCacheTransport_NoCacheRequestsBypassed(t *testing.T) {
+	server, requests := newCountingServer(t, http.StatusOK, 0)
+	client := NewCacheTransport(time.Minute, nil).Client()
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/scaffolder/v2/tasks/1", nil)
+		req.Header.Set("Cache-Control", "no-cache")
+		_, _ = client.Do(req)
+	}
+
+	assert.EqualValuesf(t, 2, requests.Load(), "Requests with no-cache header should not be served from cache")
+}
+
+func TestCacheTransport_ReadOnlyRequestsKeepCache(t *testing.T) {
+	server, requests := newCountingServer(t, http.StatusOK, 0)
+	client := NewCacheTransport(time.Minute, nil).Client()
+
+	_, _ = client.Get(server.URL + "/catalog/entities/by-name/component/default/test")
+
+	req, _ := http.NewRequestWithContext(WithReadOnly(context.Background()), http.MethodPost, server.URL+"/catalog/entities/by-refs", nil)
+	_, _ = client.Do(req)
+	_, _ = client.Get(server.URL + "/catalog/entities/by-name/component/default/test")
+	assert.EqualValuesf(t, 2, requests.Load(), "Read-only POST should keep the cached responses")
+
+	_, _ = client.Post(server.URL+"/catalog/locations", "application/json", nil)
+	_, _ = client.Get(server.URL + "/catalog/entities/by-name/component/default/test")
+	assert.EqualValuesf(t, 4, requests.Load(), "Other POST should invalidate the cached responses")
+}
@@ -0,0 +1,224 @@
+package transport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// HeaderStaleAge is the header set on responses served from the on-disk cache. It holds the age of the response in seconds.
+const HeaderStaleAge = "X-Backstage-Stale-Age"
+
+// DiskCacheTransport is a http.RoundTripper that persists the last successful response to each GET request in a directory, and serves it
+// instead, when the Backstage API cannot be reached, responds with a server error or rate limits the requests. Other client errors, e.g.
+// 404 Not Found, are returned as they are. GET requests with the "Cache-Control: no-cache" header are neither stored nor served from the
+// cache.
+type DiskCacheTransport struct {
+	// BaseTransport is the underlying HTTP transport to use when making requests. It will default to http.DefaultTransport if nil.
+	BaseTransport http.RoundTripper
+
+	dir      string
+	maxStale time.Duration
+	now      func() time.Time
+}
+
+// diskCacheEntry is a response persisted in the on-disk cache.
+type diskCacheEntry struct {
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	StoredAt   time.Time   `json:"stored_at"`
+}
+
+// NewDiskCacheTransport returns a new DiskCacheTransport, storing responses in the given directory, which is created if it does not exist.
+// Stored responses older than maxStale are not served. Non-positive maxStale allows serving responses of any age.
+func NewDiskCacheTransport(dir string, maxStale time.Duration, base http.RoundTripper) (*DiskCacheTransport, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &DiskCacheTransport{BaseTransport: base, dir: dir, maxStale: maxStale, now: time.Now}, nil
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *DiskCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || noCache(req) {
+		return t.transport().RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	key := req.URL.String()
+
+	resp, err := t.transport().RoundTrip(req)
+	if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err := t.store(key, resp, body); err != nil {
+			tflog.Warn(ctx, "Unable to store response in cache directory", map[string]interface{}{"backstage_http_url": key, "error": err.Error()})
+		}
+
+		return resp, nil
+	}
+
+	// Requests cancelled by the caller are not served from cache, as nobody waits for the response.
+	if ctx.Err() != nil {
+		return resp, err
+	}
+
+	// Client errors are answers of a reachable API, e.g. that the entity was deleted, so they are returned as they are.
+	if err == nil && !serverUnavailable(resp) {
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+			if err := os.Remove(t.path(key)); err != nil && !os.IsNotExist(err) {
+				tflog.Warn(ctx, "Unable to remove response from cache directory", map[string]interface{}{"backstage_http_url": key, "error": err.Error()})
+			}
+		}
+
+		return resp, nil
+	}
+
+	entry, loadErr := t.load(key)
+	if loadErr != nil {
+		if !os.IsNotExist(loadErr) {
+			tflog.Warn(ctx, "Unable to load response from cache directory", map[string]interface{}{"backstage_http_url": key, "error": loadErr.Error()})
+		}
+
+		return resp, err
+	}
+
+	age := t.now().Sub(entry.StoredAt)
+	if t.maxStale > 0 && age > t.maxStale {
+		tflog.Warn(ctx, "Cached response is too old to be served", map[string]interface{}{
+			"backstage_http_url":        key,
+			"backstage_cache_age_ms":    age.Milliseconds(),
+			"backstage_cache_max_stale": t.maxStale.String(),
+		})
+
+		return resp, err
+	}
+
+	fields := map[string]interface{}{"backstage_http_url": key, "backstage_cache_age_ms": age.Milliseconds()}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["backstage_http_status_code"] = resp.StatusCode
+		_ = resp.Body.Close()
+	}
+	tflog.Warn(ctx, "Serving stale response from cache directory", fields)
+
+	header := entry.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set(HeaderStaleAge, strconv.FormatInt(int64(age.Seconds()), 10))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Client returns an *http.Client that falls back to the cached responses.
+func (t *DiskCacheTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// StaleAge returns the age of the response, if it was served from the on-disk cache.
+func StaleAge(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseInt(resp.Header.Get(HeaderStaleAge), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
+
+// store persists the response for the key. The file is written atomically, so that concurrent runs never read a partial one.
+func (t *DiskCacheTransport) store(key string, resp *http.Response, body []byte) error {
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	data, err := json.Marshal(diskCacheEntry{URL: key, StatusCode: resp.StatusCode, Header: header, Body: body, StoredAt: t.now()})
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(t.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), t.path(key))
+}
+
+// load returns the persisted response for the key.
+func (t *DiskCacheTransport) load(key string) (*diskCacheEntry, error) {
+	data, err := os.ReadFile(t.path(key))
+	if err != nil {
+		return nil, err
+	}
+
+	var entry diskCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// path returns the path of the file holding the response for the key.
+func (t *DiskCacheTransport) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(t.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// serverUnavailable returns true, if the response indicates the API cannot serve the request at the moment: a server error or rate limiting.
+func serverUnavailable(resp *http.Response) bool {
+	return resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// transport returns the underlying HTTP transport. If none is set, http.DefaultTransport is used.
+func (t *DiskCacheTransport) transport() http.RoundTripper {
+	if t.BaseTransport != nil {
+		return t.BaseTransport
+	}
+
+	return http.DefaultTransport
+}
@@ -0,0 +1,138 @@
+package transport
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// roundTripFunc is a http.RoundTripper implemented by a function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements the RoundTripper interface.
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDiskCacheTransport_StaleResponseServed(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"kind":"Component"}`))
+		}
+	}))
+	defer server.Close()
+
+	tr, err := NewDiskCacheTransport(t.TempDir(), 0, nil)
+	assert.NoErrorf(t, err, "Transport should be created")
+	start := time.Now()
+	tr.now = func() time.Time { return start }
+	client := tr.Client()
+
+	resp, err := client.Get(server.URL + "/entities")
+	assert.NoErrorf(t, err, "Request should not return an error")
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equalf(t, `{"kind":"Component"}`, string(body), "Live response should contain the body")
+	_, stale := StaleAge(resp)
+	assert.Falsef(t, stale, "Live response should not be stale")
+
+	status = http.StatusServiceUnavailable
+	tr.now = func() time.Time { return start.Add(90 * time.Minute) }
+
+	resp, err = client.Get(server.URL + "/entities")
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Stale response should preserve the original status")
+	assert.Equalf(t, "application/json", resp.Header.Get("Content-Type"), "Stale response should preserve the original headers")
+	body, _ = io.ReadAll(resp.Body)
+	assert.Equalf(t, `{"kind":"Component"}`, string(body), "Stale response should contain the original body")
+	age, stale := StaleAge(resp)
+	assert.Truef(t, stale, "Response should be stale")
+	assert.Equalf(t, 90*time.Minute, age, "Response age should be reported")
+
+	resp, err = client.Get(server.URL + "/other")
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusServiceUnavailable, resp.StatusCode, "Uncached response should be returned as is")
+}
+
+func TestDiskCacheTransport_ErrorsFallBack(t *testing.T) {
+	var fail bool
+	tr, err := NewDiskCacheTransport(t.TempDir(), time.Hour, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if fail {
+			return nil, errors.New("connection refused")
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(http.NoBody), Request: req}, nil
+	}))
+	assert.NoErrorf(t, err, "Transport should be created")
+	start := time.Now()
+	tr.now = func() time.Time { return start }
+	client := tr.Client()
+
+	_, err = client.Get("http://backstage.test/entities")
+	assert.NoErrorf(t, err, "Request should not return an error")
+
+	fail = true
+	_, err = client.Get("http://backstage.test/entities")
+	assert.NoErrorf(t, err, "Stale response should be served on connection errors")
+
+	tr.now = func() time.Time { return start.Add(2 * time.Hour) }
+	_, err = client.Get("http://backstage.test/entities")
+	assert.Errorf(t, err, "Responses older than max stale should not be served")
+}
+
+func TestDiskCacheTransport_OnlyGETCached(t *testing.T) {
+	var requests int
+	tr, err := NewDiskCacheTransport(t.TempDir(), 0, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		if requests > 1 {
+			return nil, errors.New("connection refused")
+		}
+		return &http.Response{StatusCode: http.StatusCreated, Header: http.Header{}, Body: io.NopCloser(http.NoBody), Request: req}, nil
+	}))
+	assert.NoErrorf(t, err, "Transport should be created")
+	client := tr.Client()
+
+	_, err = client.Post("http://backstage.test/locations", "application/json", nil)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	_, err = client.Post("http://backstage.test/locations", "application/json", nil)
+	assert.Errorf(t, err, "Non-GET requests should not be served from cache")
+}
+
+func TestDiskCacheTransport_ClientErrorsPassedThrough(t *testing.T) {
+	status := http.StatusOK
+	tr, err := NewDiskCacheTransport(t.TempDir(), 0, roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(http.NoBody), Request: req}, nil
+	}))
+	assert.NoErrorf(t, err, "Transport should be created")
+	client := tr.Client()
+
+	_, err = client.Get("http://backstage.test/locations/1")
+	assert.NoErrorf(t, err, "Request should not return an error")
+
+	status = http.StatusTooManyRequests
+	resp, err := client.Get("http://backstage.test/locations/1")
+	assert.NoErrorf(t, err, "Request should not return an error")
+	_, stale := StaleAge(resp)
+	assert.Truef(t, stale, "Stale response should be served, when rate limited")
+
+	status = http.StatusForbidden
+	resp, err = client.Get("http://backstage.test/locations/1")
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusForbidden, resp.StatusCode, "Client errors should be returned as they are")
+
+	status = http.StatusNotFound
+	resp, err = client.Get("http://backstage.test/locations/1")
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusNotFound, resp.StatusCode, "Deleted resources should not be served from cache")
+
+	status = http.StatusServiceUnavailable
+	resp, err = client.Get("http://backstage.test/locations/1")
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusServiceUnavailable, resp.StatusCode, "Responses of deleted resources should be removed from cache")
+}
@@ -0,0 +1,105 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultSensitiveHeadersPattern matches names of headers that are likely to contain credentials.
+	DefaultSensitiveHeadersPattern = `(?i)(token|secret|password|api-?key|session)`
+
+	// maskedValue replaces values of sensitive headers in logs, the same way tflog masks them.
+	maskedValue = "***"
+
+	// minMaskedLength is the minimal length of a sensitive value to be masked in log messages. Shorter values are only masked in header fields,
+	// as masking them everywhere would render the logs unreadable.
+	minMaskedLength = 4
+)
+
+// alwaysSensitiveHeaders is a set of (canonical) header names that are always considered sensitive.
+var alwaysSensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// HeaderMasker decides which HTTP headers are sensitive and masks their values in logs.
+type HeaderMasker struct {
+	pattern *regexp.Regexp
+}
+
+// NewHeaderMasker returns a new HeaderMasker that considers sensitive the headers that are always sensitive (e.g. Authorization or Cookie)
+// and those with names matching the pattern. If pattern is empty, DefaultSensitiveHeadersPattern is used.
+func NewHeaderMasker(pattern string) (*HeaderMasker, error) {
+	if pattern == "" {
+		pattern = DefaultSensitiveHeadersPattern
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HeaderMasker{pattern: regex}, nil
+}
+
+// IsSensitive returns true if values of the header with the given name must not be logged.
+func (m *HeaderMasker) IsSensitive(name string) bool {
+	return alwaysSensitiveHeaders[http.CanonicalHeaderKey(name)] || m.pattern.MatchString(name)
+}
+
+// Redact returns a copy of the headers with values of the sensitive ones replaced.
+func (m *HeaderMasker) Redact(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if m.IsSensitive(k) {
+			v = maskedValue
+		}
+		redacted[k] = v
+	}
+
+	return redacted
+}
+
+// Mask returns a context, which logger masks values of the sensitive headers both in field values and in messages.
+func (m *HeaderMasker) Mask(ctx context.Context, header http.Header) context.Context {
+	var keys, values []string
+	for name, vs := range header {
+		if !m.IsSensitive(name) {
+			continue
+		}
+
+		keys = append(keys, headerFieldKey(name))
+		for _, v := range vs {
+			if len(v) >= minMaskedLength {
+				values = append(values, v)
+			}
+			if _, token, ok := strings.Cut(v, " "); ok && len(token) >= minMaskedLength {
+				values = append(values, token)
+			}
+		}
+	}
+
+	if len(keys) == 0 {
+		return ctx
+	}
+
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, keys...)
+	if len(values) > 0 {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, values...)
+		ctx = tflog.MaskMessageStrings(ctx, values...)
+	}
+
+	return ctx
+}
+
+// headerFieldKey returns the key of the log field holding value of the header with the given name.
+func headerFieldKey(name string) string {
+	return "backstage_http_header_" + strings.ToLower(name)
+}
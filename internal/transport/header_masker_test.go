@@ -0,0 +1,44 @@
+package transport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderMasker_IsSensitive(t *testing.T) {
+	masker, err := NewHeaderMasker("")
+	assert.NoErrorf(t, err, "NewHeaderMasker should not return an error")
+
+	for _, name := range []string{"Authorization", "authorization", "Cookie", "Proxy-Authorization", "X-Auth-Token", "X-Api-Key", "Client-Secret"} {
+		assert.Truef(t, masker.IsSensitive(name), "Header %s should be sensitive", name)
+	}
+
+	for _, name := range []string{"Accept", "Content-Type", "X-Request-Id"} {
+		assert.Falsef(t, masker.IsSensitive(name), "Header %s should not be sensitive", name)
+	}
+
+	masker, err = NewHeaderMasker("(?i)^x-tenant$")
+	assert.NoErrorf(t, err, "NewHeaderMasker should not return an error")
+	assert.Truef(t, masker.IsSensitive("X-Tenant"), "Header matching custom pattern should be sensitive")
+	assert.Truef(t, masker.IsSensitive("Authorization"), "Authorization header should always be sensitive")
+	assert.Falsef(t, masker.IsSensitive("X-Auth-Token"), "Custom pattern should replace the default one")
+
+	_, err = NewHeaderMasker("(")
+	assert.Errorf(t, err, "NewHeaderMasker should return an error for invalid pattern")
+}
+
+func TestHeaderMasker_Redact(t *testing.T) {
+	masker, _ := NewHeaderMasker("")
+
+	headers := map[string]string{
+		"Authorization": "Bearer secret-token",
+		"Custom-Header": "header_value",
+	}
+
+	assert.Equalf(t, map[string]string{
+		"Authorization": "***",
+		"Custom-Header": "header_value",
+	}, masker.Redact(headers), "Redact should mask sensitive headers only")
+	assert.Equalf(t, "Bearer secret-token", headers["Authorization"], "Redact should not modify the original headers")
+}
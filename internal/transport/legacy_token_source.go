@@ -0,0 +1,124 @@
+package transport
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultLegacyTokenSubject is the subject Backstage expects in legacy backend-to-backend tokens.
+	DefaultLegacyTokenSubject = "backstage-server"
+
+	// DefaultLegacyTokenTTL is the lifetime of minted tokens, matching the one used by Backstage itself.
+	DefaultLegacyTokenTTL = time.Hour
+
+	// legacyTokenRefreshMargin defines how long before the expiry a token is considered stale and gets re-minted.
+	legacyTokenRefreshMargin = time.Minute
+)
+
+// LegacyTokenSource is a TokenSource that mints HS256 JWT tokens signed with a shared secret, as configured in `backend.auth.keys` or
+// `backend.auth.externalAccess` (type `legacy`) of the Backstage instance. Tokens are cached and re-minted shortly before they expire.
+type LegacyTokenSource struct {
+	secret  []byte
+	subject string
+	ttl     time.Duration
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+
+	// now returns the current time. It is only overridden in tests.
+	now func() time.Time
+}
+
+// NewLegacyTokenSource returns a new LegacyTokenSource for the base64 encoded secret. If subject is empty, DefaultLegacyTokenSubject is used,
+// and if ttl is not positive, DefaultLegacyTokenTTL is used.
+func NewLegacyTokenSource(secret string, subject string, ttl time.Duration) (*LegacyTokenSource, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if subject == "" {
+		subject = DefaultLegacyTokenSubject
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultLegacyTokenTTL
+	}
+
+	return &LegacyTokenSource{
+		secret:  key,
+		subject: subject,
+		ttl:     ttl,
+		now:     time.Now,
+	}, nil
+}
+
+// Token implements the TokenSource interface.
+func (s *LegacyTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.token != "" && now.Add(legacyTokenRefreshMargin).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	expiry := now.Add(s.ttl)
+	token, err := s.sign(map[string]interface{}{
+		"sub": s.subject,
+		"iat": now.Unix(),
+		"exp": expiry.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.token, s.expiry = token, expiry
+
+	return s.token, nil
+}
+
+// sign returns a compact serialized JWT with the given claims, signed using HS256 algorithm.
+func (s *LegacyTokenSource) sign(claims map[string]interface{}) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// decodeSecret decodes the base64 encoded secret, accepting both standard and URL-safe alphabets, with or without padding (the same way
+// Backstage does).
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		return nil, errors.New("secret cannot be empty")
+	}
+
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if key, err := enc.DecodeString(secret); err == nil {
+			return key, nil
+		}
+	}
+
+	return nil, errors.New("secret must be base64 encoded")
+}
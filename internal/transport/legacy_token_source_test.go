@@ -0,0 +1,66 @@
+package transport
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testLegacySecret = "c2VjcmV0LWtleS11c2VkLWZvci10ZXN0cw=="
+
+func TestLegacyTokenSource_TokenSigned(t *testing.T) {
+	source, err := NewLegacyTokenSource(testLegacySecret, "", 0)
+	assert.NoErrorf(t, err, "NewLegacyTokenSource should not return an error")
+
+	token, err := source.Token()
+	assert.NoErrorf(t, err, "Token should not return an error")
+
+	parts := strings.Split(token, ".")
+	assert.Lenf(t, parts, 3, "Token should be a compact serialized JWT")
+
+	key, _ := base64.StdEncoding.DecodeString(testLegacySecret)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	assert.Equalf(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2], "Token should be signed with the secret")
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	assert.NoErrorf(t, err, "Token payload should be base64 encoded")
+
+	var claims struct {
+		Sub string `json:"sub"`
+		Exp int64  `json:"exp"`
+	}
+	assert.NoErrorf(t, json.Unmarshal(payload, &claims), "Token payload should be valid JSON")
+	assert.Equalf(t, DefaultLegacyTokenSubject, claims.Sub, "Token should use the default subject")
+	assert.InDeltaf(t, time.Now().Add(DefaultLegacyTokenTTL).Unix(), claims.Exp, 5, "Token should expire after the default TTL")
+}
+
+func TestLegacyTokenSource_TokenRefreshed(t *testing.T) {
+	source, err := NewLegacyTokenSource(testLegacySecret, "external:terraform", 10*time.Minute)
+	assert.NoErrorf(t, err, "NewLegacyTokenSource should not return an error")
+
+	now := time.Now()
+	source.now = func() time.Time { return now }
+
+	first, _ := source.Token()
+	second, _ := source.Token()
+	assert.Equalf(t, first, second, "Token should be reused while it is valid")
+
+	now = now.Add(9*time.Minute + 30*time.Second)
+	third, _ := source.Token()
+	assert.NotEqualf(t, first, third, "Token should be refreshed before it expires")
+}
+
+func TestNewLegacyTokenSource_InvalidSecret(t *testing.T) {
+	_, err := NewLegacyTokenSource("not base64!", "", 0)
+	assert.Errorf(t, err, "NewLegacyTokenSource should return an error for invalid secret")
+
+	_, err = NewLegacyTokenSource("", "", 0)
+	assert.Errorf(t, err, "NewLegacyTokenSource should return an error for empty secret")
+}
@@ -0,0 +1,110 @@
+package transport
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// LimitTransport is a http.RoundTripper that limits the rate and the concurrency of requests. A single instance is meant to be shared by all
+// the data sources and resources, so that they draw from one budget.
+type LimitTransport struct {
+	// BaseTransport is the underlying HTTP transport to use when making requests. It will default to http.DefaultTransport if nil.
+	BaseTransport http.RoundTripper
+
+	limiter   *rate.Limiter
+	semaphore chan struct{}
+}
+
+// NewLimitTransport returns a new LimitTransport allowing up to requestsPerSecond requests per second and up to maxConcurrent requests in flight.
+// Non-positive values disable the corresponding limit.
+func NewLimitTransport(requestsPerSecond float64, maxConcurrent int, base http.RoundTripper) *LimitTransport {
+	t := &LimitTransport{BaseTransport: base}
+
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), int(math.Max(1, math.Ceil(requestsPerSecond))))
+	}
+
+	if maxConcurrent > 0 {
+		t.semaphore = make(chan struct{}, maxConcurrent)
+	}
+
+	return t
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+		default:
+			tflog.Trace(ctx, "Waiting for a slot to send request to Backstage API")
+			select {
+			case t.semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			t.release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil || t.semaphore == nil {
+		t.release()
+		return resp, err
+	}
+
+	// The slot is held until the response body is closed, as the request is in flight until then.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: t.release}
+
+	return resp, nil
+}
+
+// Client returns an *http.Client that makes rate and concurrency limited requests.
+func (t *LimitTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// release frees the slot acquired for a request, if concurrency is limited.
+func (t *LimitTransport) release() {
+	if t.semaphore != nil {
+		<-t.semaphore
+	}
+}
+
+// transport returns the underlying HTTP transport. If none is set, http.DefaultTransport is used.
+func (t *LimitTransport) transport() http.RoundTripper {
+	if t.BaseTransport != nil {
+		return t.BaseTransport
+	}
+
+	return http.DefaultTransport
+}
+
+// releasingBody is a response body, which calls release once it is closed.
+type releasingBody struct {
+	io.ReadCloser
+
+	once    sync.Once
+	release func()
+}
+
+// Close closes the body and calls release.
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
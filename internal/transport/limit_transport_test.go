@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitTransport_ConcurrencyLimited(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewLimitTransport(0, 2, nil).Client()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL)
+			if assert.NoErrorf(t, err, "Request should not return an error") {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqualf(t, maxInFlight.Load(), int32(2), "No more than 2 requests should be in flight")
+}
+
+func TestLimitTransport_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewLimitTransport(10, 0, nil).Client()
+
+	start := time.Now()
+	for i := 0; i < 15; i++ {
+		resp, err := client.Get(server.URL)
+		if assert.NoErrorf(t, err, "Request should not return an error") {
+			_ = resp.Body.Close()
+		}
+	}
+
+	assert.GreaterOrEqualf(t, time.Since(start), 400*time.Millisecond, "Requests should be rate limited")
+}
+
+func TestLimitTransport_ContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewLimitTransport(0, 1, nil).Client()
+
+	resp, err := client.Get(server.URL)
+	assert.NoErrorf(t, err, "Request should not return an error")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	_, err = client.Do(req)
+	assert.ErrorIsf(t, err, context.DeadlineExceeded, "Request should wait for a slot until the context is done")
+
+	_ = resp.Body.Close()
+	resp, err = client.Get(server.URL)
+	assert.NoErrorf(t, err, "Request should not return an error once the slot is released")
+	_ = resp.Body.Close()
+}
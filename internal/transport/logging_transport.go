@@ -0,0 +1,76 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LoggingTransport is a http.RoundTripper that logs requests sent to the Backstage API, masking values of the sensitive headers.
+type LoggingTransport struct {
+	// Masker decides which headers are sensitive. Masker created with the default pattern is used if nil.
+	Masker *HeaderMasker
+
+	// BaseTransport is the underlying HTTP transport to use when making requests. It will default to http.DefaultTransport if nil.
+	BaseTransport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := t.logContext(req)
+	tflog.Debug(ctx, "Sending request to Backstage API")
+
+	start := time.Now()
+	resp, err := t.transport().RoundTrip(req)
+	ctx = tflog.SetField(ctx, "backstage_http_duration_ms", time.Since(start).Milliseconds())
+
+	if err != nil {
+		tflog.Debug(ctx, "Request to Backstage API failed", map[string]interface{}{"error": err.Error()})
+		return resp, err
+	}
+
+	tflog.Debug(ctx, "Received response from Backstage API", map[string]interface{}{"backstage_http_status_code": resp.StatusCode})
+
+	return resp, nil
+}
+
+// Client returns an *http.Client that logs requests.
+func (t *LoggingTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// logContext returns a context of the request, enriched with log fields describing it.
+func (t *LoggingTransport) logContext(req *http.Request) context.Context {
+	ctx := t.masker().Mask(req.Context(), req.Header)
+	ctx = tflog.SetField(ctx, "backstage_http_method", req.Method)
+	ctx = tflog.SetField(ctx, "backstage_http_url", req.URL.String())
+
+	for name, values := range req.Header {
+		ctx = tflog.SetField(ctx, headerFieldKey(name), strings.Join(values, ", "))
+	}
+
+	return ctx
+}
+
+// masker returns the configured HeaderMasker, or the default one if none is set.
+func (t *LoggingTransport) masker() *HeaderMasker {
+	if t.Masker != nil {
+		return t.Masker
+	}
+
+	m, _ := NewHeaderMasker("")
+
+	return m
+}
+
+// transport returns the underlying HTTP transport. If none is set, http.DefaultTransport is used.
+func (t *LoggingTransport) transport() http.RoundTripper {
+	if t.BaseTransport != nil {
+		return t.BaseTransport
+	}
+
+	return http.DefaultTransport
+}
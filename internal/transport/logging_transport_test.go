@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggingTransport_SensitiveHeadersMasked(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equalf(t, "Bearer secret-token", r.Header.Get("Authorization"), "Request should contain the original header")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/catalog/entities?token=secret-token", nil)
+	req.Header.Set("Authorization", "Bearer secret-token")
+	req.Header.Set("Custom-Header", "header_value")
+
+	resp, err := (&LoggingTransport{}).Client().Do(req)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Request should succeed")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoErrorf(t, err, "Log output should be valid JSON")
+	assert.Lenf(t, entries, 2, "Request and response should be logged")
+
+	for _, entry := range entries {
+		assert.Equalf(t, "***", entry["backstage_http_header_authorization"], "Authorization header should be masked")
+		assert.Equalf(t, "header_value", entry["backstage_http_header_custom-header"], "Custom header should not be masked")
+		assert.NotContainsf(t, entry["backstage_http_url"], "secret-token", "Token should be masked in other fields")
+	}
+	assert.EqualValuesf(t, http.StatusOK, entries[1]["backstage_http_status_code"], "Response status code should be logged")
+}
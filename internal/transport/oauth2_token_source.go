@@ -0,0 +1,36 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// oauth2TokenSource is a TokenSource backed by an oauth2.TokenSource.
+type oauth2TokenSource struct {
+	source oauth2.TokenSource
+}
+
+// NewOAuth2TokenSource returns a TokenSource that obtains tokens using the OAuth2 client credentials flow. Tokens are cached and requested
+// again only once they are about to expire. Requests to the token endpoint are sent using the provided client (http.DefaultClient, if nil).
+func NewOAuth2TokenSource(config *clientcredentials.Config, client *http.Client) TokenSource {
+	// The context is kept for the lifetime of the token source and used for each token request, so it must not be bound to a single RPC.
+	ctx := context.Background()
+	if client != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+	}
+
+	return &oauth2TokenSource{source: config.TokenSource(ctx)}
+}
+
+// Token implements the TokenSource interface.
+func (s *oauth2TokenSource) Token() (string, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
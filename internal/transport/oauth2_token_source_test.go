@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func TestOAuth2TokenSource_TokenRequested(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		clientID, clientSecret, _ := r.BasicAuth()
+		assert.Equalf(t, "test-client", clientID, "Token request should contain client ID")
+		assert.Equalf(t, "test-secret", clientSecret, "Token request should contain client secret")
+
+		assert.NoErrorf(t, r.ParseForm(), "Token request should be a valid form")
+		assert.Equalf(t, "client_credentials", r.PostForm.Get("grant_type"), "Token request should use client credentials grant")
+		assert.Equalf(t, "catalog.read catalog.write", r.PostForm.Get("scope"), "Token request should contain scopes")
+		assert.Equalf(t, "backstage", r.PostForm.Get("audience"), "Token request should contain audience")
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "test-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	defer server.Close()
+
+	source := NewOAuth2TokenSource(&clientcredentials.Config{
+		ClientID:       "test-client",
+		ClientSecret:   "test-secret",
+		TokenURL:       server.URL,
+		Scopes:         []string{"catalog.read", "catalog.write"},
+		EndpointParams: url.Values{"audience": {"backstage"}},
+	}, server.Client())
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token()
+		assert.NoErrorf(t, err, "Token should not return an error")
+		assert.Equalf(t, "test-token", token, "Token should return the access token")
+	}
+
+	assert.Equalf(t, 1, requests, "Token should be cached until it expires")
+}
+
+func TestOAuth2TokenSource_TokenEndpointError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer server.Close()
+
+	source := NewOAuth2TokenSource(&clientcredentials.Config{
+		ClientID:     "test-client",
+		ClientSecret: "wrong-secret",
+		TokenURL:     server.URL,
+	}, server.Client())
+
+	_, err := source.Token()
+	assert.ErrorContainsf(t, err, "invalid_client", "Token should return the token endpoint error")
+}
@@ -0,0 +1,122 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultRetryMinWait is the default minimal time to wait before retrying a request.
+	DefaultRetryMinWait = 1 * time.Second
+
+	// DefaultRetryMaxWait is the default maximal time to wait before retrying a request.
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryPolicy describes when and how requests to the Backstage API are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximal number of attempts to send a request, including the first one.
+	MaxAttempts int
+
+	// MinWait is the minimal time to wait before retrying a request. The wait time grows exponentially with each attempt.
+	MinWait time.Duration
+
+	// MaxWait is the maximal time to wait before retrying a request.
+	MaxWait time.Duration
+
+	// RetryOnStatus is a list of response status codes to retry on. If empty, requests are retried on 429 and 5xx (except 501) responses.
+	// Requests failing due to recoverable connection errors are always retried.
+	RetryOnStatus []int
+
+	// RespectRetryAfter makes the client wait for the duration requested by the Retry-After header of 429 and 503 responses.
+	RespectRetryAfter bool
+}
+
+// noRetryKey is the context key marking requests, which must not be retried.
+type noRetryKey struct{}
+
+// WithoutRetries returns a copy of the context, whose requests are sent only once by the client returned by NewRetryableClient. It is meant
+// for non-idempotent requests, e.g. the ones starting a Scaffolder task, where an attempt timing out after the server accepted it would
+// otherwise repeat the action.
+func WithoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// NewRetryableClient returns an *http.Client, which retries requests according to the policy. Each attempt is sent using the base transport
+// (http.DefaultTransport, if nil). Once the attempts are exhausted, the last response is returned as it is.
+func NewRetryableClient(policy RetryPolicy, base http.RoundTripper, timeout time.Duration) *http.Client {
+	client := retryablehttp.NewClient()
+	client.Logger = nil
+	client.HTTPClient.Timeout = timeout
+	if base != nil {
+		client.HTTPClient.Transport = base
+	}
+
+	client.RetryMax = policy.MaxAttempts - 1
+	client.RetryWaitMin = policy.MinWait
+	if client.RetryWaitMin <= 0 {
+		client.RetryWaitMin = DefaultRetryMinWait
+	}
+	client.RetryWaitMax = policy.MaxWait
+	if client.RetryWaitMax <= 0 {
+		client.RetryWaitMax = DefaultRetryMaxWait
+	}
+
+	client.CheckRetry = policy.checkRetry
+	client.Backoff = policy.backoff
+	client.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	client.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+		if attempt > 0 {
+			tflog.Info(req.Context(), "Retrying request to Backstage API", map[string]interface{}{
+				"backstage_http_method":  req.Method,
+				"backstage_http_url":     req.URL.String(),
+				"backstage_http_attempt": attempt + 1,
+			})
+		}
+	}
+
+	return client.StandardClient()
+}
+
+// checkRetry decides whether the request should be retried, based on the error or the response received.
+func (p RetryPolicy) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if noRetry, _ := ctx.Value(noRetryKey{}).(bool); noRetry {
+		return false, ctx.Err()
+	}
+
+	var retry bool
+	var checkErr error
+	if err != nil || len(p.RetryOnStatus) == 0 {
+		retry, checkErr = retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	} else if checkErr = ctx.Err(); checkErr == nil {
+		retry = slices.Contains(p.RetryOnStatus, resp.StatusCode)
+	}
+
+	if retry {
+		fields := map[string]interface{}{}
+		if err != nil {
+			fields["error"] = err.Error()
+		}
+		if resp != nil {
+			fields["backstage_http_status_code"] = resp.StatusCode
+			fields["backstage_http_retry_after"] = resp.Header.Get("Retry-After")
+		}
+		tflog.Debug(ctx, "Request to Backstage API failed with recoverable error", fields)
+	}
+
+	return retry, checkErr
+}
+
+// backoff returns the time to wait before the next attempt.
+func (p RetryPolicy) backoff(min, max time.Duration, attempt int, resp *http.Response) time.Duration {
+	if !p.RespectRetryAfter {
+		resp = nil
+	}
+
+	return retryablehttp.DefaultBackoff(min, max, attempt, resp)
+}
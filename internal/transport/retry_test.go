@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFlakyServer returns a server which responds with the given status codes (and Retry-After header) before finally responding with 200.
+func newFlakyServer(t *testing.T, retryAfter string, statuses ...int) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { requests++ }()
+
+		if requests < len(statuses) {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statuses[requests])
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestNewRetryableClient_RetriesOnDefaultStatuses(t *testing.T) {
+	server, requests := newFlakyServer(t, "", http.StatusTooManyRequests, http.StatusBadGateway)
+
+	client := NewRetryableClient(RetryPolicy{MaxAttempts: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}, nil, time.Second)
+
+	resp, err := client.Get(server.URL)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Request should eventually succeed")
+	assert.Equalf(t, 3, *requests, "Request should be attempted 3 times")
+}
+
+func TestNewRetryableClient_RetriesOnConfiguredStatuses(t *testing.T) {
+	server, requests := newFlakyServer(t, "", http.StatusConflict, http.StatusBadGateway)
+
+	client := NewRetryableClient(RetryPolicy{
+		MaxAttempts:   3,
+		MinWait:       time.Millisecond,
+		MaxWait:       time.Millisecond,
+		RetryOnStatus: []int{http.StatusConflict},
+	}, nil, time.Second)
+
+	resp, err := client.Get(server.URL)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusBadGateway, resp.StatusCode, "Request should not be retried on status not configured")
+	assert.Equalf(t, 2, *requests, "Request should be attempted 2 times")
+}
+
+func TestNewRetryableClient_AttemptsExhausted(t *testing.T) {
+	server, requests := newFlakyServer(t, "", http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+
+	client := NewRetryableClient(RetryPolicy{MaxAttempts: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond}, nil, time.Second)
+
+	resp, err := client.Get(server.URL)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusServiceUnavailable, resp.StatusCode, "Last response should be returned")
+	assert.Equalf(t, 2, *requests, "Request should be attempted 2 times")
+}
+
+func TestNewRetryableClient_RetryAfter(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond, RespectRetryAfter: true}
+
+	server, _ := newFlakyServer(t, "1", http.StatusTooManyRequests)
+	start := time.Now()
+	resp, err := NewRetryableClient(policy, nil, 5*time.Second).Get(server.URL)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Request should eventually succeed")
+	assert.GreaterOrEqualf(t, time.Since(start), time.Second, "Retry-After header should be respected")
+
+	policy.RespectRetryAfter = false
+	server, _ = newFlakyServer(t, "1", http.StatusTooManyRequests)
+	start = time.Now()
+	resp, err = NewRetryableClient(policy, nil, 5*time.Second).Get(server.URL)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Request should eventually succeed")
+	assert.Lessf(t, time.Since(start), time.Second, "Retry-After header should be ignored")
+}
+
+func TestNewRetryableClient_WithoutRetries(t *testing.T) {
+	server, requests := newFlakyServer(t, "", http.StatusBadGateway)
+
+	client := NewRetryableClient(RetryPolicy{MaxAttempts: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}, nil, time.Second)
+
+	req, _ := http.NewRequestWithContext(WithoutRetries(context.Background()), http.MethodPost, server.URL, nil)
+	resp, err := client.Do(req)
+	assert.NoErrorf(t, err, "Request should not return an error")
+	assert.Equalf(t, http.StatusBadGateway, resp.StatusCode, "Response of the only attempt should be returned")
+	assert.Equalf(t, 1, *requests, "Request should be attempted once")
+}
@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TLSConfig describes TLS settings used when connecting to the Backstage API.
+type TLSConfig struct {
+	// CACertPEM is a PEM encoded bundle of CA certificates to trust in addition to the system ones.
+	CACertPEM []byte
+
+	// ClientCertPEM is a PEM encoded client certificate to present to the server (mutual TLS).
+	ClientCertPEM []byte
+
+	// ClientKeyPEM is a PEM encoded private key of the client certificate.
+	ClientKeyPEM []byte
+
+	// InsecureSkipVerify disables verification of the server certificate chain and host name.
+	InsecureSkipVerify bool
+
+	// ServerName overrides the host name used to verify the server certificate (and sent via SNI).
+	ServerName string
+}
+
+// IsEmpty returns true if none of the TLS settings is set.
+func (c TLSConfig) IsEmpty() bool {
+	return len(c.CACertPEM) == 0 && len(c.ClientCertPEM) == 0 && len(c.ClientKeyPEM) == 0 && !c.InsecureSkipVerify && c.ServerName == ""
+}
+
+// NewTLSTransport returns a clone of http.DefaultTransport that uses the provided TLS settings.
+func NewTLSTransport(c TLSConfig) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+		ServerName:         c.ServerName,
+	}
+
+	if len(c.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(c.CACertPEM) {
+			return nil, errors.New("no valid PEM encoded CA certificates found")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(c.ClientCertPEM) > 0 || len(c.ClientKeyPEM) > 0 {
+		if len(c.ClientCertPEM) == 0 || len(c.ClientKeyPEM) == 0 {
+			return nil, errors.New("both client certificate and client key must be provided")
+		}
+
+		cert, err := tls.X509KeyPair(c.ClientCertPEM, c.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = tlsConfig
+
+	return t, nil
+}
@@ -0,0 +1,100 @@
+package transport
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClientCert returns a self-signed PEM encoded client certificate and its private key.
+func newTestClientCert(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoErrorf(t, err, "GenerateKey should not return an error")
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+	}
+	template.BasicConstraintsValid = true
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoErrorf(t, err, "CreateCertificate should not return an error")
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoErrorf(t, err, "MarshalECPrivateKey should not return an error")
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestNewTLSTransport_MutualTLS(t *testing.T) {
+	clientCert, clientKey := newTestClientCert(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(clientCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	transport, err := NewTLSTransport(TLSConfig{CACertPEM: caCert, ClientCertPEM: clientCert, ClientKeyPEM: clientKey})
+	assert.NoErrorf(t, err, "NewTLSTransport should not return an error")
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	assert.NoErrorf(t, err, "Request with client certificate should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Request with client certificate should succeed")
+
+	transport, _ = NewTLSTransport(TLSConfig{CACertPEM: caCert})
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Errorf(t, err, "Request without client certificate should return an error")
+}
+
+func TestNewTLSTransport_UntrustedServer(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport, err := NewTLSTransport(TLSConfig{ServerName: "example.com"})
+	assert.NoErrorf(t, err, "NewTLSTransport should not return an error")
+
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Errorf(t, err, "Request to server with untrusted certificate should return an error")
+
+	transport, _ = NewTLSTransport(TLSConfig{InsecureSkipVerify: true})
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	assert.NoErrorf(t, err, "Request with disabled verification should not return an error")
+	assert.Equalf(t, http.StatusOK, resp.StatusCode, "Request with disabled verification should succeed")
+}
+
+func TestNewTLSTransport_InvalidConfig(t *testing.T) {
+	_, err := NewTLSTransport(TLSConfig{CACertPEM: []byte("not a certificate")})
+	assert.Errorf(t, err, "NewTLSTransport should return an error for invalid CA certificate")
+
+	clientCert, _ := newTestClientCert(t)
+	_, err = NewTLSTransport(TLSConfig{ClientCertPEM: clientCert})
+	assert.Errorf(t, err, "NewTLSTransport should return an error for client certificate without key")
+
+	assert.Truef(t, TLSConfig{}.IsEmpty(), "IsEmpty should return true for empty config")
+	assert.Falsef(t, TLSConfig{ServerName: "example.com"}.IsEmpty(), "IsEmpty should return false for non-empty config")
+}
@@ -0,0 +1,56 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// TokenSource is a source of bearer tokens used to authenticate requests to the Backstage API.
+type TokenSource interface {
+	// Token returns a token that is valid at the time of the call. Implementations are expected to cache and refresh tokens as needed.
+	Token() (string, error)
+}
+
+// StaticTokenSource is a TokenSource that always returns the same token, e.g. a static external access token.
+type StaticTokenSource string
+
+// Token implements the TokenSource interface.
+func (s StaticTokenSource) Token() (string, error) {
+	return string(s), nil
+}
+
+// TokenTransport is a http.RoundTripper that authenticates requests with a bearer token obtained from TokenSource.
+type TokenTransport struct {
+	// TokenSource is the source of tokens to set in the Authorization header of each request.
+	TokenSource TokenSource
+
+	// BaseTransport is the underlying HTTP transport to use when making requests. It will default to http.DefaultTransport if nil.
+	BaseTransport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.TokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain Backstage API token: %w", err)
+	}
+
+	req = cloneRequest(req)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return t.transport().RoundTrip(req)
+}
+
+// Client returns an *http.Client that makes authenticated requests.
+func (t *TokenTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// transport returns the underlying HTTP transport. If none is set, http.DefaultTransport is used.
+func (t *TokenTransport) transport() http.RoundTripper {
+	if t.BaseTransport != nil {
+		return t.BaseTransport
+	}
+
+	return http.DefaultTransport
+}
@@ -0,0 +1,80 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/datolabs-io/go-backstage/v3"
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingTokenSource struct{}
+
+func (failingTokenSource) Token() (string, error) {
+	return "", errors.New("token endpoint unavailable")
+}
+
+func TestTokenTransport_TokenAdded(t *testing.T) {
+	const baseURL = "http://localhost:7007"
+
+	defer gock.Off()
+	gock.New(baseURL).
+		MatchHeader("Authorization", "^Bearer test-token$").
+		Reply(http.StatusOK)
+
+	client, err := backstage.NewClient(baseURL, "default", &http.Client{
+		Transport: &TokenTransport{
+			TokenSource: StaticTokenSource("test-token"),
+		},
+	})
+
+	assert.NoErrorf(t, err, "NewClient should not return an error")
+	_, _, err = client.Catalog.Entities.List(context.Background(), &backstage.ListEntityOptions{})
+
+	assert.NoErrorf(t, err, "ListEntities should not return an error")
+}
+
+func TestTokenTransport_OverridesAuthorizationHeader(t *testing.T) {
+	const baseURL = "http://localhost:7007"
+
+	defer gock.Off()
+	gock.New(baseURL).
+		MatchHeader("Authorization", "^Bearer test-token$").
+		Reply(http.StatusOK)
+
+	client, err := backstage.NewClient(baseURL, "default", &http.Client{
+		Transport: &HeadersTransport{
+			Headers: map[string]string{"Authorization": "Bearer stale-token"},
+			BaseTransport: &TokenTransport{
+				TokenSource: StaticTokenSource("test-token"),
+			},
+		},
+	})
+
+	assert.NoErrorf(t, err, "NewClient should not return an error")
+	_, _, err = client.Catalog.Entities.List(context.Background(), &backstage.ListEntityOptions{})
+
+	assert.NoErrorf(t, err, "ListEntities should not return an error")
+}
+
+func TestTokenTransport_TokenSourceError(t *testing.T) {
+	const baseURL = "http://localhost:7007"
+
+	defer gock.Off()
+	gock.New(baseURL).
+		Reply(http.StatusOK)
+
+	client, err := backstage.NewClient(baseURL, "default", &http.Client{
+		Transport: &TokenTransport{
+			TokenSource: failingTokenSource{},
+		},
+	})
+
+	assert.NoErrorf(t, err, "NewClient should not return an error")
+	_, _, err = client.Catalog.Entities.List(context.Background(), &backstage.ListEntityOptions{})
+
+	assert.ErrorContainsf(t, err, "token endpoint unavailable", "ListEntities should return token source error")
+}